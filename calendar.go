@@ -0,0 +1,151 @@
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// WorkdayCalendar describes which days are business days: every day that
+// isn't on a weekend day or a holiday. The zero value is a calendar with no
+// weekend days and no holidays, the same as NewWorkdayCalendar().
+type WorkdayCalendar struct {
+	weekend  [7]bool
+	holidays map[Date]bool
+}
+
+// NewWorkdayCalendar returns a calendar with the given weekend days and no
+// holidays. With no arguments, every day of the week is a business day. It
+// panics if a weekend day isn't between time.Sunday and time.Saturday, or if
+// every day of the week is a weekend day, since such a calendar would have no
+// business days at all.
+func NewWorkdayCalendar(weekend ...time.Weekday) *WorkdayCalendar {
+	var c WorkdayCalendar
+	for _, w := range weekend {
+		if w < time.Sunday || w > time.Saturday {
+			panic(fmt.Sprintf("civil.NewWorkdayCalendar: invalid weekday %d", int(w)))
+		}
+		c.weekend[w] = true
+	}
+
+	if c.businessDaysPerWeek() == 0 {
+		panic("civil.NewWorkdayCalendar: every day is a weekend day")
+	}
+
+	return &c
+}
+
+// NewMondayToFridayCalendar returns a calendar with a Saturday and Sunday
+// weekend and no holidays.
+func NewMondayToFridayCalendar() *WorkdayCalendar {
+	return NewWorkdayCalendar(time.Saturday, time.Sunday)
+}
+
+// AddHolidays adds dates to the calendar's holidays, returning the calendar
+// so calls can be chained.
+func (c *WorkdayCalendar) AddHolidays(dates ...Date) *WorkdayCalendar {
+	if c.holidays == nil {
+		c.holidays = make(map[Date]bool)
+	}
+	for _, d := range dates {
+		c.holidays[d] = true
+	}
+	return c
+}
+
+func (c *WorkdayCalendar) IsWeekend(d Date) bool {
+	return c.weekend[d.Weekday()]
+}
+
+func (c *WorkdayCalendar) IsHoliday(d Date) bool {
+	return c.holidays[d]
+}
+
+func (c *WorkdayCalendar) IsBusinessDay(d Date) bool {
+	return !c.IsWeekend(d) && !c.IsHoliday(d)
+}
+
+func (c *WorkdayCalendar) businessDaysPerWeek() int {
+	n := 7
+	for _, w := range c.weekend {
+		if w {
+			n--
+		}
+	}
+	return n
+}
+
+// AddBusinessDays returns the date n business days after d. Negative values
+// of n step backwards. If d isn't a business day, counting starts from d
+// itself, so with a Saturday and Sunday weekend a Saturday plus one business
+// day is the following Monday.
+func (c *WorkdayCalendar) AddBusinessDays(d Date, n int) Date {
+	if n == 0 {
+		return d
+	}
+
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	// Shift non-business days onto the adjacent business day that counting
+	// would have started from. Without holidays, that lets whole weeks be
+	// skipped arithmetically.
+	for !c.IsBusinessDay(d) {
+		d = d.AddDays(-step)
+	}
+
+	if len(c.holidays) == 0 {
+		perWeek := c.businessDaysPerWeek()
+		d = d.AddDays(step * (n / perWeek) * 7)
+		n %= perWeek
+	}
+
+	for n > 0 {
+		d = d.AddDays(step)
+		if c.IsBusinessDay(d) {
+			n--
+		}
+	}
+
+	return d
+}
+
+// BusinessDaysBetween returns the number of business days from start up to
+// but not including end. If end is before start, the result is the negated
+// count from end up to start.
+func (c *WorkdayCalendar) BusinessDaysBetween(start, end Date) int {
+	if end.Before(start) {
+		return -c.BusinessDaysBetween(end, start)
+	}
+
+	days := end.DaysSince(start)
+	n := days / 7 * c.businessDaysPerWeek()
+	for d := start.AddDays(days / 7 * 7); d.Before(end); d = d.AddDays(1) {
+		if !c.IsWeekend(d) {
+			n++
+		}
+	}
+
+	for h := range c.holidays {
+		if !c.IsWeekend(h) && h.AfterOrOn(start) && h.Before(end) {
+			n--
+		}
+	}
+
+	return n
+}
+
+// NextBusinessDay returns the first business day strictly after d.
+func (c *WorkdayCalendar) NextBusinessDay(d Date) Date {
+	for d = d.AddDays(1); !c.IsBusinessDay(d); d = d.AddDays(1) {
+	}
+	return d
+}
+
+// PreviousBusinessDay returns the last business day strictly before d.
+func (c *WorkdayCalendar) PreviousBusinessDay(d Date) Date {
+	for d = d.AddDays(-1); !c.IsBusinessDay(d); d = d.AddDays(-1) {
+	}
+	return d
+}
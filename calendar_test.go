@@ -0,0 +1,192 @@
+package civil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkdayCalendarIsBusinessDay(t *testing.T) {
+	c := NewWorkdayCalendar(time.Friday, time.Saturday).AddHolidays(Date{2016, 3, 8})
+
+	for _, test := range []struct {
+		d                          Date
+		weekend, holiday, business bool
+	}{
+		{Date{2016, 3, 3}, false, false, true},  // thursday
+		{Date{2016, 3, 4}, true, false, false},  // friday
+		{Date{2016, 3, 5}, true, false, false},  // saturday
+		{Date{2016, 3, 6}, false, false, true},  // sunday
+		{Date{2016, 3, 8}, false, true, false},  // tuesday holiday
+		{Date{2016, 3, 11}, true, false, false}, // friday
+	} {
+		if got := c.IsWeekend(test.d); got != test.weekend {
+			t.Errorf("IsWeekend(%v) = %t, want %t", test.d, got, test.weekend)
+		}
+		if got := c.IsHoliday(test.d); got != test.holiday {
+			t.Errorf("IsHoliday(%v) = %t, want %t", test.d, got, test.holiday)
+		}
+		if got := c.IsBusinessDay(test.d); got != test.business {
+			t.Errorf("IsBusinessDay(%v) = %t, want %t", test.d, got, test.business)
+		}
+	}
+}
+
+func TestWorkdayCalendarMondayToFriday(t *testing.T) {
+	c := NewMondayToFridayCalendar()
+	for d := (Date{2016, 3, 1}); d.Before(Date{2016, 4, 1}); d = d.AddDays(1) {
+		if got := c.IsWeekend(d); got != d.IsWeekend() {
+			t.Errorf("IsWeekend(%v) = %t, want %t", d, got, d.IsWeekend())
+		}
+	}
+}
+
+func TestWorkdayCalendarNoWeekend(t *testing.T) {
+	c := NewWorkdayCalendar()
+	for d := (Date{2016, 3, 1}); d.Before(Date{2016, 4, 1}); d = d.AddDays(1) {
+		if c.IsWeekend(d) {
+			t.Errorf("IsWeekend(%v) = true, want false", d)
+		}
+	}
+	assert.Equal(t, Date{2016, 3, 8}, c.AddBusinessDays(Date{2016, 3, 1}, 7))
+	assert.Equal(t, 7, c.BusinessDaysBetween(Date{2016, 3, 1}, Date{2016, 3, 8}))
+}
+
+func TestWorkdayCalendarZeroValue(t *testing.T) {
+	var c WorkdayCalendar
+	c.AddHolidays(Date{2016, 3, 2})
+	assert.True(t, c.IsHoliday(Date{2016, 3, 2}))
+	assert.True(t, c.IsBusinessDay(Date{2016, 3, 5}))
+	assert.Equal(t, Date{2016, 3, 3}, c.NextBusinessDay(Date{2016, 3, 1}))
+	assert.Equal(t, 6, c.BusinessDaysBetween(Date{2016, 3, 1}, Date{2016, 3, 8}))
+}
+
+func TestWorkdayCalendarPanics(t *testing.T) {
+	assert.PanicsWithValue(t, "civil.NewWorkdayCalendar: every day is a weekend day", func() {
+		NewWorkdayCalendar(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+	})
+	assert.PanicsWithValue(t, "civil.NewWorkdayCalendar: invalid weekday 7", func() {
+		NewWorkdayCalendar(7)
+	})
+	assert.PanicsWithValue(t, "civil.NewWorkdayCalendar: invalid weekday -1", func() {
+		NewWorkdayCalendar(-1)
+	})
+}
+
+func TestWorkdayCalendarAddBusinessDays(t *testing.T) {
+	fridaySaturday := NewWorkdayCalendar(time.Friday, time.Saturday)
+	holidays := NewMondayToFridayCalendar().AddHolidays(Date{2015, 12, 25}, Date{2015, 12, 28}, Date{2016, 1, 1})
+
+	for _, test := range []struct {
+		desc  string
+		c     *WorkdayCalendar
+		start Date
+		end   Date
+		n     int
+	}{
+		{
+			desc:  "friday saturday weekend, thursday to sunday",
+			c:     fridaySaturday,
+			start: Date{2016, 3, 3},
+			end:   Date{2016, 3, 6},
+			n:     1,
+		},
+		{
+			desc:  "friday saturday weekend, starting on friday",
+			c:     fridaySaturday,
+			start: Date{2016, 3, 4},
+			end:   Date{2016, 3, 6},
+			n:     1,
+		},
+		{
+			desc:  "friday saturday weekend, sunday backwards",
+			c:     fridaySaturday,
+			start: Date{2016, 3, 6},
+			end:   Date{2016, 3, 3},
+			n:     -1,
+		},
+		{
+			desc:  "friday saturday weekend, many weeks",
+			c:     fridaySaturday,
+			start: Date{2016, 3, 6},
+			end:   Date{2016, 5, 15},
+			n:     50,
+		},
+		{
+			desc:  "holidays over christmas",
+			c:     holidays,
+			start: Date{2015, 12, 24},
+			end:   Date{2015, 12, 29},
+			n:     1,
+		},
+		{
+			desc:  "holidays over christmas, many days",
+			c:     holidays,
+			start: Date{2015, 12, 21},
+			end:   Date{2016, 1, 7},
+			n:     10,
+		},
+		{
+			desc:  "holidays over christmas, backwards",
+			c:     holidays,
+			start: Date{2016, 1, 7},
+			end:   Date{2015, 12, 21},
+			n:     -10,
+		},
+		{
+			desc:  "starting on a holiday",
+			c:     holidays,
+			start: Date{2015, 12, 25},
+			end:   Date{2015, 12, 29},
+			n:     1,
+		},
+	} {
+		if got := test.c.AddBusinessDays(test.start, test.n); got != test.end {
+			t.Errorf("[%s] AddBusinessDays(%v, %d) = %v, want %v", test.desc, test.start, test.n, got, test.end)
+		}
+	}
+}
+
+func TestWorkdayCalendarBusinessDaysBetween(t *testing.T) {
+	fridaySaturday := NewWorkdayCalendar(time.Friday, time.Saturday)
+	holidays := NewMondayToFridayCalendar().AddHolidays(Date{2015, 12, 25}, Date{2015, 12, 26}, Date{2016, 1, 1})
+
+	for _, test := range []struct {
+		desc       string
+		c          *WorkdayCalendar
+		start, end Date
+		want       int
+	}{
+		{"friday saturday weekend, one week", fridaySaturday, Date{2016, 3, 6}, Date{2016, 3, 13}, 5},
+		{"friday saturday weekend, thursday to sunday", fridaySaturday, Date{2016, 3, 3}, Date{2016, 3, 6}, 1},
+		{"holidays", holidays, Date{2015, 12, 21}, Date{2016, 1, 4}, 8},
+		{"holidays reversed", holidays, Date{2016, 1, 4}, Date{2015, 12, 21}, -8},
+		{"holiday outside the range", holidays, Date{2016, 1, 4}, Date{2016, 1, 11}, 5},
+	} {
+		if got := test.c.BusinessDaysBetween(test.start, test.end); got != test.want {
+			t.Errorf("[%s] BusinessDaysBetween(%v, %v) = %d, want %d", test.desc, test.start, test.end, got, test.want)
+		}
+	}
+}
+
+func TestWorkdayCalendarConsistency(t *testing.T) {
+	c := NewWorkdayCalendar(time.Friday, time.Saturday).AddHolidays(Date{2016, 3, 8}, Date{2016, 3, 20}, Date{2016, 4, 1})
+
+	start := Date{2016, 3, 1}
+	for n := 0; n < 40; n++ {
+		end := c.AddBusinessDays(start, n)
+		if got := c.BusinessDaysBetween(start, end); got != n {
+			t.Errorf("BusinessDaysBetween(%v, AddBusinessDays(%v, %d) = %v) = %d, want %d", start, start, n, end, got, n)
+		}
+	}
+
+	for d := (Date{2016, 3, 1}); d.Before(Date{2016, 4, 10}); d = d.AddDays(1) {
+		if got, want := c.NextBusinessDay(d), c.AddBusinessDays(d, 1); c.IsBusinessDay(d) && got != want {
+			t.Errorf("NextBusinessDay(%v) = %v, want %v", d, got, want)
+		}
+		if got, want := c.PreviousBusinessDay(d), c.AddBusinessDays(d, -1); c.IsBusinessDay(d) && got != want {
+			t.Errorf("PreviousBusinessDay(%v) = %v, want %v", d, got, want)
+		}
+	}
+}
@@ -2,8 +2,14 @@ package civil
 
 import (
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -19,6 +25,17 @@ func DateOf(t time.Time) Date {
 	return d
 }
 
+// Today returns the current date in loc. The date depends on the time zone,
+// so there's no default; use time.Local for the system's zone.
+func Today(loc *time.Location) Date {
+	return DateOf(time.Now().In(loc))
+}
+
+// TodayUTC returns the current date in UTC.
+func TodayUTC() Date {
+	return Today(time.UTC)
+}
+
 func DateOfNil(t *time.Time) *Date {
 	if t == nil {
 		return nil
@@ -33,33 +50,625 @@ func (d Date) Format(f string) string {
 	return d.In(time.UTC).Format(f)
 }
 
+var isoLayouts = []string{"2006-01-02", "2006-01-02T15:04:05Z07:00"}
+
+var (
+	ErrEmptyDate     = errors.New("empty date")
+	ErrInvalidFormat = errors.New("invalid date format")
+)
+
+// ParseDate parses a date in the form "2006-01-02", or an RFC 3339 timestamp
+// whose date is used as is. The returned error wraps ErrEmptyDate if s is
+// empty, ErrMonthOutOfRange or ErrDayOutOfRange if s has the right form but
+// isn't a valid date, and ErrInvalidFormat otherwise.
 func ParseDate(s string) (Date, error) {
-	t, err := time.Parse("2006-01-02", s)
+	if s == "" {
+		return Date{}, fmt.Errorf("civil.ParseDate: %w", ErrEmptyDate)
+	}
+
+	// Fast path for the common "2006-01-02" form, avoiding time.Parse.
+	if d, ok := parseDateDigits(s); ok {
+		if err := d.check(); err != nil {
+			return Date{}, fmt.Errorf("civil.ParseDate: %w: %q", err, s)
+		}
+		return d, nil
+	}
+
+	t, err := time.Parse(isoLayouts[1], s)
 	if err != nil {
-		if t, err := time.Parse("2006-01-02T15:04:05Z07:00", s); err == nil {
-			return DateOf(t), nil
+		// time.Parse also rejects timestamps with an impossible date. If the
+		// rest of the timestamp is fine, report what's wrong with the date.
+		if len(s) > 10 {
+			if d, ok := parseDateDigits(s[:10]); ok {
+				if _, err := time.Parse(isoLayouts[1], "2000-01-01"+s[10:]); err == nil {
+					if err := d.check(); err != nil {
+						return Date{}, fmt.Errorf("civil.ParseDate: %w: %q", err, s)
+					}
+				}
+			}
+		}
+
+		return Date{}, fmt.Errorf("civil.ParseDate: %w: %q", ErrInvalidFormat, s)
+	}
+
+	return DateOf(t), nil
+}
+
+// parseDateDigits parses s if it's exactly in the form "2006-01-02", without
+// checking that the result is a valid date.
+func parseDateDigits(s string) (Date, bool) {
+	if len(s) != 10 || s[4] != '-' || s[7] != '-' || !isDigits(s[0:4]) || !isDigits(s[5:7]) || !isDigits(s[8:10]) {
+		return Date{}, false
+	}
+
+	return Date{
+		Year:  int(s[0]-'0')*1000 + int(s[1]-'0')*100 + int(s[2]-'0')*10 + int(s[3]-'0'),
+		Month: time.Month(int(s[5]-'0')*10 + int(s[6]-'0')),
+		Day:   int(s[8]-'0')*10 + int(s[9]-'0'),
+	}, true
+}
+
+// MustParseDate is like ParseDate, but panics if s can't be parsed. It's
+// intended for initialising test fixtures and package level variables.
+func MustParseDate(s string) Date {
+	d, err := ParseDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// ParseDateLenient is like ParseDate, but doesn't require zero padding. It
+// accepts dates in the form Y-M-D, where the year has one to four digits and
+// the month and day have one or two, e.g. "2016-1-2" or "3-2-4".
+func ParseDateLenient(s string) (Date, error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 3 {
+		return Date{}, fmt.Errorf("civil.ParseDateLenient: can't parse %q", s)
+	}
+
+	var v [3]int
+	for i, p := range parts {
+		if max := []int{4, 2, 2}[i]; len(p) < 1 || len(p) > max {
+			return Date{}, fmt.Errorf("civil.ParseDateLenient: can't parse %q", s)
+		}
+		for _, c := range p {
+			if c < '0' || c > '9' {
+				return Date{}, fmt.Errorf("civil.ParseDateLenient: can't parse %q", s)
+			}
 		}
+		v[i], _ = strconv.Atoi(p)
+	}
+
+	d := Date{Year: v[0], Month: time.Month(v[1]), Day: v[2]}
+	if !d.IsValid() {
+		return Date{}, fmt.Errorf("civil.ParseDateLenient: %q is not a valid date", s)
+	}
+
+	return d, nil
+}
+
+// ParseDateCompact parses a date in the compact form "20060102". The input
+// must be exactly eight digits.
+func ParseDateCompact(s string) (Date, error) {
+	if len(s) != 8 || !isDigits(s) {
+		return Date{}, fmt.Errorf("civil.ParseDateCompact: can't parse %q", s)
+	}
+
+	year, _ := strconv.Atoi(s[0:4])
+	month, _ := strconv.Atoi(s[4:6])
+	day, _ := strconv.Atoi(s[6:8])
 
-		return Date{}, err
+	d := Date{Year: year, Month: time.Month(month), Day: day}
+	if !d.IsValid() {
+		return Date{}, fmt.Errorf("civil.ParseDateCompact: %q is not a valid date", s)
+	}
+
+	return d, nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseDateInLayout parses value using a time.Parse layout, discarding any
+// time of day. The returned error wraps the one from time.Parse.
+func ParseDateInLayout(layout, value string) (Date, error) {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return Date{}, fmt.Errorf("civil.ParseDateInLayout: can't parse %q: %w", value, err)
 	}
 
 	return DateOf(t), nil
 }
 
+// ParseDateAny tries to parse s with each of layouts in turn using time.Parse,
+// returning the first success. If no layouts are given, "2006-01-02" and
+// RFC 3339 are tried. If every layout fails, the returned error wraps the
+// error from the last one.
+func ParseDateAny(s string, layouts ...string) (Date, error) {
+	if len(layouts) == 0 {
+		layouts = isoLayouts
+	}
+
+	var err error
+	for _, layout := range layouts {
+		var t time.Time
+		if t, err = time.Parse(layout, s); err == nil {
+			return DateOf(t), nil
+		}
+	}
+
+	return Date{}, fmt.Errorf("civil.ParseDateAny: can't parse %q using any of %q: %w", s, layouts, err)
+}
+
+// String returns d in the form "2006-01-02". Years are zero padded to at
+// least four digits, with a leading minus sign for negative years.
 func (d Date) String() string {
-	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+	var buf [32]byte
+	return string(d.AppendFormat(buf[:0]))
+}
+
+// GoString returns d as a Go expression, e.g.
+// "civil.Date{1987, time.April, 15}", which is what %#v prints.
+func (d Date) GoString() string {
+	month := fmt.Sprintf("time.Month(%d)", d.Month)
+	if d.Month >= time.January && d.Month <= time.December {
+		month = "time." + d.Month.String()
+	}
+	return fmt.Sprintf("civil.Date{%d, %s, %d}", d.Year, month, d.Day)
+}
+
+// AppendFormat appends the textual representation of d, as returned by
+// String, to b and returns the extended buffer.
+func (d Date) AppendFormat(b []byte) []byte {
+	b = appendInt(b, d.Year, 4)
+	b = append(b, '-')
+	b = appendInt(b, int(d.Month), 2)
+	b = append(b, '-')
+	return appendInt(b, d.Day, 2)
 }
 
+// appendInt appends the decimal form of v to b, zero padded to width the same
+// way fmt's %0*d verb does it.
+func appendInt(b []byte, v, width int) []byte {
+	u := uint64(v)
+	if v < 0 {
+		b = append(b, '-')
+		u = uint64(-v)
+		width--
+	}
+
+	var buf [20]byte
+	i := len(buf)
+	for u >= 10 {
+		i--
+		buf[i] = byte('0' + u%10)
+		u /= 10
+	}
+	i--
+	buf[i] = byte('0' + u)
+
+	for n := len(buf) - i; n < width; n++ {
+		b = append(b, '0')
+	}
+
+	return append(b, buf[i:]...)
+}
+
+// OrdinalDay returns the day of the month with its English ordinal suffix,
+// e.g. "1st", "12th" or "23rd".
+func (d Date) OrdinalDay() string {
+	suffix := "th"
+	if d.Day%100 < 11 || d.Day%100 > 13 {
+		switch d.Day % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", d.Day, suffix)
+}
+
+// FormatCompact returns d in the compact form "20060102".
+func (d Date) FormatCompact() string {
+	return fmt.Sprintf("%04d%02d%02d", d.Year, d.Month, d.Day)
+}
+
+// IsZero reports whether d is the zero Date. The zero Date has a month and
+// day of zero, so it isn't valid; IsZero is for detecting an unset Date, not
+// for validation.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+var (
+	ErrMonthOutOfRange = errors.New("month out of range")
+	ErrDayOutOfRange   = errors.New("day out of range")
+)
+
+// Validate returns nil if d is a valid date, or an error wrapping
+// ErrMonthOutOfRange or ErrDayOutOfRange describing why it isn't.
+func (d Date) Validate() error {
+	switch err := d.check(); err {
+	case ErrMonthOutOfRange:
+		return fmt.Errorf("civil.Date.Validate: %w: %d", err, d.Month)
+	case ErrDayOutOfRange:
+		return fmt.Errorf("civil.Date.Validate: %w: %d for %04d-%02d, which has %d days", err, d.Day, d.Year, d.Month, maxDay(d.Year, d.Month))
+	}
+
+	return nil
+}
+
+// check returns ErrMonthOutOfRange or ErrDayOutOfRange if d isn't valid, and
+// nil if it is.
+func (d Date) check() error {
+	last := maxDay(d.Year, d.Month)
+	if last == -1 {
+		return ErrMonthOutOfRange
+	}
+	if d.Day < 1 || d.Day > last {
+		return ErrDayOutOfRange
+	}
+	return nil
+}
+
+// IsValid reports whether d's month is from January to December and its day
+// is within that month. Any year, including zero and negative years, is
+// valid.
 func (d Date) IsValid() bool {
-	return DateOf(d.In(time.UTC)) == d
+	return d.Month >= time.January && d.Month <= time.December && d.Day >= 1 && d.Day <= maxDay(d.Year, d.Month)
 }
 
 func (d Date) In(loc *time.Location) time.Time {
 	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
 }
 
+// AtTime returns the instant at the given wall clock time on d in loc, which
+// must not be nil. As with time.Date, out of range values are normalised, and
+// a time skipped or repeated by a daylight saving change is resolved the same
+// way time.Date resolves it.
+func (d Date) AtTime(hour, min, sec, nsec int, loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, hour, min, sec, nsec, loc)
+}
+
+// StartOfDay returns the first instant of d in loc. It's the same as In.
+func (d Date) StartOfDay(loc *time.Location) time.Time {
+	return d.In(loc)
+}
+
+// EndOfDay returns the last nanosecond of d in loc, 23:59:59.999999999.
+func (d Date) EndOfDay(loc *time.Location) time.Time {
+	return d.AtTime(23, 59, 59, 999999999, loc)
+}
+
+func (d Date) Weekday() time.Weekday {
+	// 1970-01-01 was a Thursday.
+	w := (d.unixDays() + int(time.Thursday)) % 7
+	if w < 0 {
+		w += 7
+	}
+	return time.Weekday(w)
+}
+
+// WeekOfMonth returns the 1-based week of the month that d falls in, where
+// weeks start on Monday as they do in ISO 8601. The week containing the
+// first of the month is always week 1, even if it's a partial week.
+func (d Date) WeekOfMonth() int {
+	return d.WeekOfMonthStarting(time.Monday)
+}
+
+// WeekOfMonthStarting is like WeekOfMonth, but weeks start on weekStart.
+func (d Date) WeekOfMonthStarting(weekStart time.Weekday) int {
+	first := Date{Year: d.Year, Month: d.Month, Day: 1}
+	offset := (int(first.Weekday()) - int(weekStart) + 7) % 7
+	return (d.Day-1+offset)/7 + 1
+}
+
+// IsWeekend reports whether d falls on a Saturday or Sunday.
+func (d Date) IsWeekend() bool {
+	switch d.Weekday() {
+	case time.Saturday, time.Sunday:
+		return true
+	}
+	return false
+}
+
+func (d Date) IsWeekday() bool {
+	return !d.IsWeekend()
+}
+
+func (d Date) ISOWeek() (year, week int) {
+	return d.In(time.UTC).ISOWeek()
+}
+
+func (d Date) ISOYear() int {
+	year, _ := d.ISOWeek()
+	return year
+}
+
+// FormatISOWeek returns d as an ISO 8601 week date, e.g. "2016-W01-5". The
+// year is the ISO week-numbering year, which differs from the calendar year
+// near the start and end of some years, so 2016-01-01 is "2015-W53-5".
+func (d Date) FormatISOWeek() string {
+	year, week := d.ISOWeek()
+	weekday := (int(d.Weekday())+6)%7 + 1
+	return fmt.Sprintf("%04d-W%02d-%d", year, week, weekday)
+}
+
+// ParseISOWeekDate parses an ISO 8601 week date in the form "2006-W01-2",
+// where the year is the ISO week-numbering year, the week is from 01 to 52
+// or 53, and the weekday is from 1 (Monday) to 7 (Sunday).
+func ParseISOWeekDate(s string) (Date, error) {
+	if len(s) != 10 || s[4] != '-' || s[5] != 'W' || s[8] != '-' || !isDigits(s[0:4]) || !isDigits(s[6:8]) || !isDigits(s[9:10]) {
+		return Date{}, fmt.Errorf("civil.ParseISOWeekDate: %w: %q", ErrInvalidFormat, s)
+	}
+
+	year, _ := strconv.Atoi(s[0:4])
+	week, _ := strconv.Atoi(s[6:8])
+	weekday := int(s[9] - '0')
+
+	// Week 1 is the week containing the 4th of January, and the last week is
+	// the one containing the 28th of December.
+	_, weeks := Date{Year: year, Month: time.December, Day: 28}.ISOWeek()
+	if week < 1 || week > weeks {
+		return Date{}, fmt.Errorf("civil.ParseISOWeekDate: %d has no week %d: %q", year, week, s)
+	}
+	if weekday < 1 || weekday > 7 {
+		return Date{}, fmt.Errorf("civil.ParseISOWeekDate: weekday out of range: %q", s)
+	}
+
+	start := Date{Year: year, Month: time.January, Day: 4}.PreviousOrSameWeekday(time.Monday)
+	return start.AddDays((week-1)*7 + weekday - 1), nil
+}
+
+// NthWeekdayOfMonth returns the nth occurrence of weekday in the given month.
+// Negative values of n count backwards from the end of the month, so n = -1
+// is the last occurrence. An error is returned if n is zero or if the month
+// doesn't have that many occurrences of weekday.
+func NthWeekdayOfMonth(year int, month time.Month, weekday time.Weekday, n int) (Date, error) {
+	last := maxDay(year, month)
+	if last == -1 {
+		return Date{}, fmt.Errorf("civil.NthWeekdayOfMonth: invalid month %d", month)
+	}
+
+	var day int
+	switch {
+	case n > 0:
+		first := Date{Year: year, Month: month, Day: 1}
+		day = 1 + (int(weekday)-int(first.Weekday())+7)%7 + (n-1)*7
+	case n < 0:
+		end := Date{Year: year, Month: month, Day: last}
+		day = last - (int(end.Weekday())-int(weekday)+7)%7 + (n+1)*7
+	default:
+		return Date{}, fmt.Errorf("civil.NthWeekdayOfMonth: n must not be zero")
+	}
+
+	if day < 1 || day > last {
+		return Date{}, fmt.Errorf("civil.NthWeekdayOfMonth: %04d-%02d doesn't have %d occurrences of %v", year, month, n, weekday)
+	}
+
+	return Date{Year: year, Month: month, Day: day}, nil
+}
+
+func (d Date) LastWeekdayOfMonth(weekday time.Weekday) Date {
+	end := Date{Year: d.Year, Month: d.Month, Day: d.LastOfMonth()}
+	return end.AddDays(-((int(end.Weekday()) - int(weekday) + 7) % 7))
+}
+
+// NextWeekday returns the first date strictly after d that falls on w.
+func (d Date) NextWeekday(w time.Weekday) Date {
+	return d.AddDays(1).NextOrSameWeekday(w)
+}
+
+// NextOrSameWeekday returns the first date on or after d that falls on w.
+func (d Date) NextOrSameWeekday(w time.Weekday) Date {
+	return d.AddDays((int(w) - int(d.Weekday()) + 7) % 7)
+}
+
+// PreviousWeekday returns the last date strictly before d that falls on w.
+func (d Date) PreviousWeekday(w time.Weekday) Date {
+	return d.AddDays(-1).PreviousOrSameWeekday(w)
+}
+
+// PreviousOrSameWeekday returns the last date on or before d that falls on w.
+func (d Date) PreviousOrSameWeekday(w time.Weekday) Date {
+	return d.AddDays(-((int(d.Weekday()) - int(w) + 7) % 7))
+}
+
+// YearDay returns the day of the year of d, from 1 to 365 (or 366 in leap
+// years).
+func (d Date) YearDay() int {
+	return d.DaysSince(Date{Year: d.Year, Month: time.January, Day: 1}) + 1
+}
+
+// FormatOrdinal returns d as an ISO 8601 ordinal date, e.g. "2016-366".
+func (d Date) FormatOrdinal() string {
+	return fmt.Sprintf("%04d-%03d", d.Year, d.YearDay())
+}
+
+// ParseOrdinalDate parses an ISO 8601 ordinal date in the form "2006-002".
+// The day of the year must be from 001 to the number of days in the year.
+func ParseOrdinalDate(s string) (Date, error) {
+	if len(s) != 8 || s[4] != '-' || !isDigits(s[0:4]) || !isDigits(s[5:8]) {
+		return Date{}, fmt.Errorf("civil.ParseOrdinalDate: %w: %q", ErrInvalidFormat, s)
+	}
+
+	year, _ := strconv.Atoi(s[0:4])
+	day, _ := strconv.Atoi(s[5:8])
+
+	last := 365
+	if IsLeapYear(year) {
+		last = 366
+	}
+
+	if day < 1 || day > last {
+		return Date{}, fmt.Errorf("civil.ParseOrdinalDate: %w: %q", ErrDayOutOfRange, s)
+	}
+
+	return Date{Year: year, Month: time.January, Day: 1}.AddDays(day - 1), nil
+}
+
+func (d Date) Quarter() int {
+	return (int(d.Month)-1)/3 + 1
+}
+
+// Century returns the 1-based century that d falls in, counted the
+// traditional way: the 20th century is 1901 through 2000. Years are
+// astronomical, so year 0 is 1 BC; years 0 through -99 are century -1,
+// -100 through -199 are century -2, and so on. There is no century 0.
+func (d Date) Century() int {
+	if d.Year > 0 {
+		return (d.Year + 99) / 100
+	}
+	return (d.Year - 100) / 100
+}
+
+// Decade returns the first year of the decade that d falls in, counting
+// decades from years ending in zero, e.g. 1987 gives 1980. Negative years
+// round down, so -5 gives -10.
+func (d Date) Decade() int {
+	if d.Year < 0 {
+		return (d.Year - 9) / 10 * 10
+	}
+	return d.Year / 10 * 10
+}
+
+// unixDays returns the number of days between 1970-01-01 and d in the
+// proleptic Gregorian calendar. It's adapted from Howard Hinnant's
+// days_from_civil algorithm. Out of range months and days are normalised
+// the same way time.Date does it.
+func (d Date) unixDays() int {
+	y, m := d.Year, int(d.Month)-1
+	if m < 0 || m >= 12 {
+		y += m / 12
+		if m %= 12; m < 0 {
+			y--
+			m += 12
+		}
+	}
+	m++
+
+	if m <= 2 {
+		y--
+	}
+
+	era := y
+	if era < 0 {
+		era -= 399
+	}
+	era /= 400
+
+	yoe := y - era*400
+	mp := m + 9
+	if m > 2 {
+		mp = m - 3
+	}
+	doy := (153*mp+2)/5 + d.Day - 1
+	doe := yoe*365 + yoe/4 - yoe/100 + doy
+
+	return era*146097 + doe - 719468
+}
+
+// dateOfUnixDays is the inverse of unixDays, adapted from Howard Hinnant's
+// civil_from_days algorithm.
+func dateOfUnixDays(n int) Date {
+	n += 719468
+
+	era := n
+	if era < 0 {
+		era -= 146096
+	}
+	era /= 146097
+
+	doe := n - era*146097
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365
+	doy := doe - (365*yoe + yoe/4 - yoe/100)
+	mp := (5*doy + 2) / 153
+
+	y := yoe + era*400
+	m := mp + 3
+	if mp >= 10 {
+		m = mp - 9
+		y++
+	}
+
+	return Date{Year: y, Month: time.Month(m), Day: doy - (153*mp+2)/5 + 1}
+}
+
 func (d Date) AddDays(n int) Date {
-	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
+	return dateOfUnixDays(d.unixDays() + n)
+}
+
+func IsLeapYear(year int) bool {
+	return year%400 == 0 || year%4 == 0 && year%100 != 0
+}
+
+func (d Date) IsLeapYear() bool {
+	return IsLeapYear(d.Year)
+}
+
+func (d Date) AddWeeks(n int) Date {
+	return d.AddDays(n * 7)
+}
+
+// AddBusinessDays returns the date n business days after d, where business
+// days are Monday through Friday. Negative values of n step backwards. If d
+// falls on a weekend, counting starts from the weekend itself, so a Saturday
+// plus one business day is the following Monday. Use a WorkdayCalendar for
+// other weekends or to skip holidays.
+func (d Date) AddBusinessDays(n int) Date {
+	return NewMondayToFridayCalendar().AddBusinessDays(d, n)
+}
+
+// BusinessDaysBetween returns the number of weekdays from start up to but
+// not including end, skipping any that are in holidays. If end is before
+// start, the result is the negated count from end up to start.
+func BusinessDaysBetween(start, end Date, holidays ...Date) int {
+	return NewMondayToFridayCalendar().AddHolidays(holidays...).BusinessDaysBetween(start, end)
+}
+
+// CountWeekday returns the number of dates from start through end, inclusive,
+// that fall on w. It's zero if end is before start.
+func CountWeekday(start, end Date, w time.Weekday) int {
+	first := start.NextOrSameWeekday(w)
+	if first.After(end) {
+		return 0
+	}
+	return end.DaysSince(first)/7 + 1
+}
+
+// WeekdaysInMonth returns the number of days from Monday to Friday in d's
+// month.
+func (d Date) WeekdaysInMonth() int {
+	return d.DaysInMonth() - d.WeekendDaysInMonth()
+}
+
+// WeekendDaysInMonth returns the number of Saturdays and Sundays in d's
+// month.
+func (d Date) WeekendDaysInMonth() int {
+	start, end := d.StartOfMonth(), d.EndOfMonth()
+	return CountWeekday(start, end, time.Saturday) + CountWeekday(start, end, time.Sunday)
+}
+
+// NextBusinessDay returns the first weekday strictly after d that isn't one
+// of holidays.
+func (d Date) NextBusinessDay(holidays ...Date) Date {
+	return NewMondayToFridayCalendar().AddHolidays(holidays...).NextBusinessDay(d)
+}
+
+// PreviousBusinessDay returns the last weekday strictly before d that isn't
+// one of holidays.
+func (d Date) PreviousBusinessDay(holidays ...Date) Date {
+	return NewMondayToFridayCalendar().AddHolidays(holidays...).PreviousBusinessDay(d)
 }
 
 func maxDay(year int, month time.Month) int {
@@ -67,7 +676,7 @@ func maxDay(year int, month time.Month) int {
 	case time.January:
 		return 31
 	case time.February:
-		if year%400 == 0 || year%4 == 0 && year%100 != 0 {
+		if IsLeapYear(year) {
 			return 29
 		}
 
@@ -97,6 +706,23 @@ func maxDay(year int, month time.Month) int {
 	return -1
 }
 
+// DaysInMonth returns the number of days in the given month of the given
+// year, or -1 if month isn't between January and December.
+func DaysInMonth(year int, month time.Month) int {
+	return maxDay(year, month)
+}
+
+func (d Date) DaysInMonth() int {
+	return DaysInMonth(d.Year, d.Month)
+}
+
+func (d Date) DaysInYear() int {
+	if d.IsLeapYear() {
+		return 366
+	}
+	return 365
+}
+
 func clampDay(year int, month time.Month, day int) int {
 	if max := maxDay(year, month); day > max {
 		return max
@@ -104,20 +730,18 @@ func clampDay(year int, month time.Month, day int) int {
 	return day
 }
 
+// AddMonths returns the date n months after d. If d's day doesn't exist in
+// the resulting month, it's clamped to the last day of that month, so
+// 2014-01-31 plus one month is 2014-02-28. See AddMonthsOverflow for the
+// alternative.
 func (d Date) AddMonths(n int) Date {
-	year := d.Year
 	month := (int(d.Month) - 1) + n
 
-	if month >= 12 {
-		for month >= 12 {
-			year++
-			month -= 12
-		}
-	} else if month < 0 {
-		for month < 0 {
-			year--
-			month += 12
-		}
+	// Floor division, so negative months borrow from the previous year.
+	year := d.Year + month/12
+	if month %= 12; month < 0 {
+		year--
+		month += 12
 	}
 
 	return Date{
@@ -127,19 +751,154 @@ func (d Date) AddMonths(n int) Date {
 	}
 }
 
+// AddMonthsOverflow is like AddMonths, but if d's day doesn't exist in the
+// resulting month the excess days overflow into the following month, the
+// same way time.Time.AddDate behaves. 2014-01-31 plus one month is
+// 2014-03-03.
+func (d Date) AddMonthsOverflow(n int) Date {
+	clamped := d.AddMonths(n)
+	return clamped.AddDays(d.Day - clamped.Day)
+}
+
+// AddYears returns the date n years after d. If d is the 29th of February
+// and the resulting year isn't a leap year, the day is clamped to the 28th.
+func (d Date) AddYears(n int) Date {
+	year := d.Year + n
+	return Date{Year: year, Month: d.Month, Day: clampDay(year, d.Month, d.Day)}
+}
+
+// AddDate returns the date that is the given number of years, months and
+// days after d. Like time.Time.AddDate, years and months are added first and
+// days last, but unlike time.Time.AddDate the day is clamped to the end of
+// the resulting month rather than overflowing into the next one. Years and
+// months are applied as a single step, so the day is only clamped once.
+func (d Date) AddDate(years, months, days int) Date {
+	return d.AddMonths(years*12 + months).AddDays(days)
+}
+
 func (d Date) SetDayClamped(day int) Date {
 	return Date{Year: d.Year, Month: d.Month, Day: clampDay(d.Year, d.Month, day)}
 }
 
+// SetMonthClamped returns d with its month set to m, clamping the day to the
+// last day of m, so the 31st of January becomes the 28th or 29th of
+// February.
+func (d Date) SetMonthClamped(m time.Month) Date {
+	return Date{Year: d.Year, Month: m, Day: clampDay(d.Year, m, d.Day)}
+}
+
+// WithYear returns d with its year set to y. Like AddYears, the 29th of
+// February becomes the 28th if y isn't a leap year.
+func (d Date) WithYear(y int) Date {
+	return Date{Year: y, Month: d.Month, Day: clampDay(y, d.Month, d.Day)}
+}
+
+// WithMonth returns d with its month set to m. If d's day doesn't exist in
+// m, it's clamped to the last day of m. To change the month without
+// clamping, use a Date literal and check it with Validate. It's equivalent
+// to SetMonthClamped.
+func (d Date) WithMonth(m time.Month) Date {
+	return d.SetMonthClamped(m)
+}
+
+// WithDay returns d with its day set to day, clamped to the last day of d's
+// month. It's equivalent to SetDayClamped.
+func (d Date) WithDay(day int) Date {
+	return d.SetDayClamped(day)
+}
+
 func (d Date) DaysSince(s Date) (days int) {
-	deltaUnix := d.In(time.UTC).Unix() - s.In(time.UTC).Unix()
-	return int(deltaUnix / 86400)
+	return d.unixDays() - s.unixDays()
+}
+
+// Midpoint returns the date halfway between a and b. If there's an odd
+// number of days between them, the result is rounded towards the earlier of
+// the two dates. Midpoint(a, b) is always equal to Midpoint(b, a).
+func Midpoint(a, b Date) Date {
+	if b.Before(a) {
+		a, b = b, a
+	}
+	return a.AddDays(b.DaysSince(a) / 2)
+}
+
+// Min returns the earliest of dates, or the zero Date if there are none.
+func Min(dates ...Date) Date {
+	var m Date
+	for i, d := range dates {
+		if i == 0 || d.Before(m) {
+			m = d
+		}
+	}
+	return m
+}
+
+// Max returns the latest of dates, or the zero Date if there are none.
+func Max(dates ...Date) Date {
+	var m Date
+	for i, d := range dates {
+		if i == 0 || d.After(m) {
+			m = d
+		}
+	}
+	return m
+}
+
+// Earliest returns the earliest of dates, and false if dates is empty.
+func Earliest(dates []Date) (Date, bool) {
+	return Min(dates...), len(dates) > 0
+}
+
+// Latest returns the latest of dates, and false if dates is empty.
+func Latest(dates []Date) (Date, bool) {
+	return Max(dates...), len(dates) > 0
+}
+
+// Clamp returns lo if d is before lo, hi if d is after hi, and d otherwise.
+// If lo is after hi, the two are swapped first.
+func (d Date) Clamp(lo, hi Date) Date {
+	if hi.Before(lo) {
+		lo, hi = hi, lo
+	}
+	switch {
+	case d.Before(lo):
+		return lo
+	case d.After(hi):
+		return hi
+	}
+	return d
 }
 
 func (d Date) On(other Date) bool {
 	return d == other
 }
 
+// Equal reports whether d and other are the same date. It's the same as On
+// and d == other, named to match time.Time.
+func (d Date) Equal(other Date) bool {
+	return d == other
+}
+
+// MaxDate and MinDate are sentinels for dates infinitely far in the future
+// and past, such as PostgreSQL's "infinity" and "-infinity". They're the last
+// and first days of the largest and smallest years that fit in an int32,
+// 2147483647-12-31 and -2147483648-01-01, so they sort after and before
+// every other date that can be stored in a database or in MarshalBinary's
+// format.
+var (
+	MaxDate = Date{Year: math.MaxInt32, Month: time.December, Day: 31}
+	MinDate = Date{Year: math.MinInt32, Month: time.January, Day: 1}
+)
+
+// IsInfinity reports whether d is MaxDate.
+func (d Date) IsInfinity() bool {
+	return d == MaxDate
+}
+
+// IsNegativeInfinity reports whether d is MinDate.
+func (d Date) IsNegativeInfinity() bool {
+	return d == MinDate
+}
+
 func (d Date) Before(other Date) bool {
 	if d.Year != other.Year {
 		return d.Year < other.Year
@@ -162,10 +921,134 @@ func (d Date) AfterOrOn(other Date) bool {
 	return d.On(other) || d.After(other)
 }
 
+// Between reports whether d is after start and before end. incStart and
+// incEnd control whether d being on start or end, respectively, counts as
+// being between them. If end is before start, no date is between them.
+func (d Date) Between(start, end Date, incStart, incEnd bool) bool {
+	afterStart := d.After(start) || incStart && d.On(start)
+	beforeEnd := d.Before(end) || incEnd && d.On(end)
+	return afterStart && beforeEnd
+}
+
+// BetweenInclusive reports whether d is on or after start and on or before
+// end.
+func (d Date) BetweenInclusive(start, end Date) bool {
+	return d.Between(start, end, true, true)
+}
+
+// BetweenExclusive reports whether d is strictly after start and strictly
+// before end.
+func (d Date) BetweenExclusive(start, end Date) bool {
+	return d.Between(start, end, false, false)
+}
+
+// Compare returns -1 if d is before other, 0 if they're the same date and +1
+// if d is after other.
+func (d Date) Compare(other Date) int {
+	switch {
+	case d.Before(other):
+		return -1
+	case d.After(other):
+		return +1
+	}
+	return 0
+}
+
+func (d Date) IsSameYear(other Date) bool {
+	return d.Year == other.Year
+}
+
+func (d Date) IsSameMonth(other Date) bool {
+	return d.Year == other.Year && d.Month == other.Month
+}
+
+func (d Date) IsSameQuarter(other Date) bool {
+	return d.Year == other.Year && d.Quarter() == other.Quarter()
+}
+
+// IsSameWeek reports whether d and other fall in the same week, for weeks
+// beginning on weekStart. Weeks can span two years, so 2015-12-31 and
+// 2016-01-01, a Thursday and a Friday, are in the same week unless weeks
+// begin on Friday.
+func (d Date) IsSameWeek(other Date, weekStart time.Weekday) bool {
+	return d.StartOfWeek(weekStart) == other.StartOfWeek(weekStart)
+}
+
 func (d Date) MonthsUntil(other Date) int {
 	return int(other.Month-d.Month) + (int(other.Year-d.Year) * 12)
 }
 
+// Age returns the number of whole years between d and on, which is how old
+// someone born on d is on the date on. Someone born on the 29th of February
+// turns a year older on the 1st of March in non-leap years. If on is before
+// d, the result is the negative number of whole years from on to d.
+func (d Date) Age(on Date) int {
+	if on.Before(d) {
+		return -on.Age(d)
+	}
+
+	years := on.Year - d.Year
+	if on.Month < d.Month || on.Month == d.Month && on.Day < d.Day {
+		years--
+	}
+
+	return years
+}
+
+// LeapDayPolicy decides when the anniversary of the 29th of February falls
+// in years that aren't leap years.
+type LeapDayPolicy int
+
+const (
+	// LeapDayFeb28 observes the anniversary on the 28th of February, which
+	// matches the clamping done by AddYears.
+	LeapDayFeb28 LeapDayPolicy = iota
+	// LeapDayMar1 observes the anniversary on the 1st of March, which matches
+	// Age.
+	LeapDayMar1
+)
+
+// NextAnniversary returns the first anniversary of d strictly after the
+// given date, using LeapDayFeb28 if d is the 29th of February. The earliest
+// anniversary is one year after d, even if after is before d.
+func (d Date) NextAnniversary(after Date) Date {
+	return d.NextAnniversaryWith(after, LeapDayFeb28)
+}
+
+// NextAnniversaryWith is like NextAnniversary, but uses policy to decide
+// where the anniversary of the 29th of February falls in non-leap years.
+func (d Date) NextAnniversaryWith(after Date, policy LeapDayPolicy) Date {
+	anniversary := func(year int) Date {
+		if policy == LeapDayMar1 && d.Month == time.February && d.Day == 29 && !IsLeapYear(year) {
+			return Date{Year: year, Month: time.March, Day: 1}
+		}
+		return d.AddYears(year - d.Year)
+	}
+
+	year := after.Year
+	if year <= d.Year {
+		year = d.Year + 1
+	}
+
+	if a := anniversary(year); a.After(after) {
+		return a
+	}
+
+	return anniversary(year + 1)
+}
+
+// YearsUntil returns the number of whole years from d until other. It's the
+// same as d.Age(other), including its rule for the 29th of February.
+func (d Date) YearsUntil(other Date) int {
+	return d.Age(other)
+}
+
+// WeeksUntil returns the number of whole weeks from d until other. It's
+// negative if other is before d.
+func (d Date) WeeksUntil(other Date) int {
+	return other.DaysSince(d) / 7
+}
+
 func (d Date) FirstOfMonth() int {
 	return 1
 }
@@ -174,6 +1057,119 @@ func (d Date) LastOfMonth() int {
 	return maxDay(d.Year, d.Month)
 }
 
+func (d Date) StartOfMonth() Date {
+	return Date{Year: d.Year, Month: d.Month, Day: d.FirstOfMonth()}
+}
+
+func (d Date) EndOfMonth() Date {
+	return Date{Year: d.Year, Month: d.Month, Day: d.LastOfMonth()}
+}
+
+// StartOfWeek returns the first day of the week containing d, for weeks
+// beginning on weekStart.
+func (d Date) StartOfWeek(weekStart time.Weekday) Date {
+	return d.PreviousOrSameWeekday(weekStart)
+}
+
+// EndOfWeek returns the last day of the week containing d, for weeks
+// beginning on weekStart.
+func (d Date) EndOfWeek(weekStart time.Weekday) Date {
+	return d.StartOfWeek(weekStart).AddDays(6)
+}
+
+func (d Date) StartOfYear() Date {
+	return Date{Year: d.Year, Month: time.January, Day: 1}
+}
+
+func (d Date) EndOfYear() Date {
+	return Date{Year: d.Year, Month: time.December, Day: 31}
+}
+
+// StartOfQuarter returns the first day of the calendar quarter containing d.
+func (d Date) StartOfQuarter() Date {
+	return d.StartOfFiscalQuarter(time.January)
+}
+
+// EndOfQuarter returns the last day of the calendar quarter containing d.
+func (d Date) EndOfQuarter() Date {
+	return d.EndOfFiscalQuarter(time.January)
+}
+
+// StartOfFiscalQuarter returns the first day of the quarter containing d,
+// for a financial year beginning on the first day of yearStart.
+func (d Date) StartOfFiscalQuarter(yearStart time.Month) Date {
+	offset := (int(d.Month) - int(yearStart) + 12) % 12
+	return d.StartOfMonth().AddMonths(-(offset % 3))
+}
+
+// EndOfFiscalQuarter returns the last day of the quarter containing d, for a
+// financial year beginning on the first day of yearStart.
+func (d Date) EndOfFiscalQuarter(yearStart time.Month) Date {
+	return d.StartOfFiscalQuarter(yearStart).AddMonths(2).EndOfMonth()
+}
+
+// MonthGrid returns the weeks of the month containing d, for weeks beginning
+// on weekStart, as rows of seven dates each. The first and last rows are
+// padded with dates from the neighbouring months so every row is full, as
+// in a calendar view.
+func (d Date) MonthGrid(weekStart time.Weekday) [][]Date {
+	start := d.StartOfMonth().StartOfWeek(weekStart)
+	end := d.EndOfMonth()
+
+	var weeks [][]Date
+	for day := start; day.BeforeOrOn(end); {
+		week := make([]Date, 7)
+		for i := range week {
+			week[i] = day
+			day = day.AddDays(1)
+		}
+		weeks = append(weeks, week)
+	}
+
+	return weeks
+}
+
+// Unit is a calendar unit that a date can be truncated to.
+type Unit int
+
+const (
+	UnitDay Unit = iota
+	UnitWeek
+	UnitMonth
+	UnitQuarter
+	UnitYear
+)
+
+// Truncate returns the first day of the unit containing d. weekStart is the
+// day weeks begin on, and is only used for UnitWeek.
+func (d Date) Truncate(unit Unit, weekStart time.Weekday) Date {
+	switch unit {
+	case UnitWeek:
+		return d.StartOfWeek(weekStart)
+	case UnitMonth:
+		return d.StartOfMonth()
+	case UnitQuarter:
+		return d.StartOfQuarter()
+	case UnitYear:
+		return d.StartOfYear()
+	}
+
+	return d
+}
+
+// DaysRemainingInMonth returns the number of days after d until the end of
+// its month, not counting d itself, so it's zero on the last day of the
+// month.
+func (d Date) DaysRemainingInMonth() int {
+	return d.LastOfMonth() - d.Day
+}
+
+// DaysRemainingInYear returns the number of days after d until the end of
+// its year, not counting d itself, so it's zero on the 31st of December.
+func (d Date) DaysRemainingInYear() int {
+	return d.DaysInYear() - d.YearDay()
+}
+
 func (d Date) IsFirstOfMonth() bool {
 	return d.Day == d.FirstOfMonth()
 }
@@ -192,7 +1188,25 @@ func (d *Date) UnmarshalText(text []byte) error {
 	return err
 }
 
-func (d *Date) MarshalJSON() ([]byte, error) {
+// Set parses s with ParseDate and stores the result in d. Along with String,
+// it lets a *Date be used as a flag.Value.
+func (d *Date) Set(s string) error {
+	v, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+
+	*d = v
+
+	return nil
+}
+
+// Type returns "date", for use as a pflag.Value.
+func (d *Date) Type() string {
+	return "date"
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
 	return json.Marshal(d.String())
 }
 
@@ -212,23 +1226,173 @@ func (d *Date) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (d Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(d.String(), start)
+}
+
+func (d *Date) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := dec.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+
+	v, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+
+	*d = v
+
+	return nil
+}
+
+func (d Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: d.String()}, nil
+}
+
+func (d *Date) UnmarshalXMLAttr(attr xml.Attr) error {
+	v, err := ParseDate(attr.Value)
+	if err != nil {
+		return err
+	}
+
+	*d = v
+
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface from gopkg.in/yaml.v2
+// and gopkg.in/yaml.v3, encoding d as a plain "2006-01-02" scalar.
+func (d Date) MarshalYAML() (interface{}, error) {
+	return d.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface from
+// gopkg.in/yaml.v2, which gopkg.in/yaml.v3 also supports.
+func (d *Date) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return fmt.Errorf("civil.Date.UnmarshalYAML: %w", err)
+	}
+
+	v, err := ParseDate(s)
+	if err != nil {
+		return fmt.Errorf("civil.Date.UnmarshalYAML: %w", err)
+	}
+
+	*d = v
+
+	return nil
+}
+
+// MarshalBinary encodes d in six bytes: the year as a big-endian 32 bit
+// integer with its sign bit inverted, followed by one byte each for the
+// month and day. Inverting the sign bit means encoded dates sort in
+// chronological order when compared byte by byte.
+func (d Date) MarshalBinary() ([]byte, error) {
+	if d.Year < math.MinInt32 || d.Year > math.MaxInt32 {
+		return nil, fmt.Errorf("civil.Date.MarshalBinary: year %d out of range", d.Year)
+	}
+	if d.Month < 0 || d.Month > math.MaxUint8 || d.Day < 0 || d.Day > math.MaxUint8 {
+		return nil, fmt.Errorf("civil.Date.MarshalBinary: month or day out of range in %v", d)
+	}
+
+	b := make([]byte, 6)
+	binary.BigEndian.PutUint32(b, uint32(int32(d.Year))^(1<<31))
+	b[4] = byte(d.Month)
+	b[5] = byte(d.Day)
+
+	return b, nil
+}
+
+func (d *Date) UnmarshalBinary(data []byte) error {
+	if len(data) != 6 {
+		return fmt.Errorf("civil.Date.UnmarshalBinary: invalid length %d", len(data))
+	}
+
+	*d = Date{
+		Year:  int(int32(binary.BigEndian.Uint32(data) ^ (1 << 31))),
+		Month: time.Month(data[4]),
+		Day:   int(data[5]),
+	}
+
+	return nil
+}
+
+const gobVersion byte = 1
+
+// GobEncode encodes d as a version byte followed by the MarshalBinary form.
+func (d Date) GobEncode() ([]byte, error) {
+	b, err := d.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{gobVersion}, b...), nil
+}
+
+func (d *Date) GobDecode(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("civil.Date.GobDecode: no data")
+	}
+	if data[0] != gobVersion {
+		return fmt.Errorf("civil.Date.GobDecode: unsupported version %d", data[0])
+	}
+	return d.UnmarshalBinary(data[1:])
+}
+
+// Scan implements the sql.Scanner interface. A NULL value sets d to the zero
+// Date; to tell NULL apart from a real date, pass Rows.Scan a **Date (the
+// address of a *Date variable or field), which database/sql sets to nil for
+// NULL. PostgreSQL's "infinity" and "-infinity" are scanned as MaxDate and
+// MinDate.
 func (d *Date) Scan(src interface{}) error {
 	switch v := src.(type) {
+	case nil:
+		*d = Date{}
+		return nil
 	case time.Time:
 		*d = DateOf(v)
 		return nil
 	case string:
-		t, err := ParseDate(v)
+		return d.scanString(v)
+	case []byte:
+		return d.scanString(string(v))
+	case int64:
+		// Some drivers return dates as the number of days since the unix
+		// epoch.
+		*d = dateOfUnixDays(int(v))
+		return nil
+	default:
+		return fmt.Errorf("civil.Date.Scan: can't scan into %T", src)
+	}
+}
+
+func (d *Date) scanString(s string) error {
+	switch s {
+	case "infinity":
+		*d = MaxDate
+	case "-infinity":
+		*d = MinDate
+	default:
+		t, err := ParseDate(s)
 		if err != nil {
 			return err
 		}
 		*d = t
-		return nil
-	default:
-		return fmt.Errorf("civil.Date.Scan: can't scan into %T", src)
 	}
+
+	return nil
 }
 
+// Value implements the driver.Valuer interface. MaxDate and MinDate are
+// written as PostgreSQL's "infinity" and "-infinity".
 func (d Date) Value() (driver.Value, error) {
+	switch {
+	case d.IsInfinity():
+		return "infinity", nil
+	case d.IsNegativeInfinity():
+		return "-infinity", nil
+	}
+
 	return d.String(), nil
 }
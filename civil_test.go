@@ -1,14 +1,46 @@
 package civil
 
 import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"flag"
 	"fmt"
+	"math"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
 )
 
+func TestToday(t *testing.T) {
+	for _, loc := range []*time.Location{
+		time.UTC,
+		time.FixedZone("UTC-12", -12*60*60),
+		time.FixedZone("UTC+14", 14*60*60),
+	} {
+		// Allow for the date changing while the test runs.
+		before := DateOf(time.Now().In(loc))
+		got := Today(loc)
+		after := DateOf(time.Now().In(loc))
+
+		if got.Before(before) || got.After(after) {
+			t.Errorf("Today(%v) = %v, want %v", loc, got, before)
+		}
+	}
+
+	before := DateOf(time.Now().UTC())
+	if got := TodayUTC(); got.Before(before) || got.After(DateOf(time.Now().UTC())) {
+		t.Errorf("TodayUTC() = %v, want %v", got, before)
+	}
+}
+
 func TestDates(t *testing.T) {
 	for _, test := range []struct {
 		date     Date
@@ -44,6 +76,91 @@ func TestDates(t *testing.T) {
 	}
 }
 
+func TestGoString(t *testing.T) {
+	for _, test := range []struct {
+		date Date
+		want string
+	}{
+		{Date{1987, 4, 15}, "civil.Date{1987, time.April, 15}"},
+		{Date{-1, 12, 31}, "civil.Date{-1, time.December, 31}"},
+		{Date{}, "civil.Date{0, time.Month(0), 0}"},
+		{Date{2016, 13, 1}, "civil.Date{2016, time.Month(13), 1}"},
+	} {
+		if got := test.date.GoString(); got != test.want {
+			t.Errorf("GoString() = %q, want %q", got, test.want)
+		}
+		if got := fmt.Sprintf("%#v", test.date); got != test.want {
+			t.Errorf("Sprintf(%%#v) = %q, want %q", got, test.want)
+		}
+	}
+}
+
+func TestAppendFormat(t *testing.T) {
+	for _, test := range []struct {
+		date Date
+		want string
+	}{
+		{Date{2014, 7, 29}, "2014-07-29"},
+		{Date{999, 1, 26}, "0999-01-26"},
+		{Date{3, 2, 4}, "0003-02-04"},
+		{Date{0, 1, 1}, "0000-01-01"},
+		{Date{-1, 1, 1}, "-001-01-01"},
+		{Date{-12345, 12, 31}, "-12345-12-31"},
+		{Date{10000, 12, 31}, "10000-12-31"},
+		{Date{2016, 13, 32}, "2016-13-32"},
+	} {
+		if got := string(test.date.AppendFormat(nil)); got != test.want {
+			t.Errorf("%#v.AppendFormat(nil) = %q, want %q", test.date, got, test.want)
+		}
+		if got := string(test.date.AppendFormat([]byte("x"))); got != "x"+test.want {
+			t.Errorf("%#v.AppendFormat(\"x\") = %q, want %q", test.date, got, "x"+test.want)
+		}
+		if got := test.date.String(); got != test.want {
+			t.Errorf("%#v.String() = %q, want %q", test.date, got, test.want)
+		}
+	}
+}
+
+func TestAtTime(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		loc = time.FixedZone("EST", -5*60*60)
+	}
+
+	for _, test := range []struct {
+		date                     Date
+		hour, min, sec, nsec     int
+		loc                      *time.Location
+		want, wantStart, wantEnd time.Time
+	}{
+		{
+			date: Date{1987, 4, 15}, hour: 9, loc: time.UTC,
+			want:      time.Date(1987, 4, 15, 9, 0, 0, 0, time.UTC),
+			wantStart: time.Date(1987, 4, 15, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(1987, 4, 15, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			date: Date{2016, 12, 31}, hour: 17, min: 30, sec: 15, nsec: 1, loc: loc,
+			want:      time.Date(2016, 12, 31, 17, 30, 15, 1, loc),
+			wantStart: time.Date(2016, 12, 31, 0, 0, 0, 0, loc),
+			wantEnd:   time.Date(2016, 12, 31, 23, 59, 59, 999999999, loc),
+		},
+	} {
+		if got := test.date.AtTime(test.hour, test.min, test.sec, test.nsec, test.loc); !got.Equal(test.want) {
+			t.Errorf("%v.AtTime(%d, %d, %d, %d, %v) = %v, want %v", test.date, test.hour, test.min, test.sec, test.nsec, test.loc, got, test.want)
+		}
+		if got := test.date.StartOfDay(test.loc); !got.Equal(test.wantStart) {
+			t.Errorf("%v.StartOfDay(%v) = %v, want %v", test.date, test.loc, got, test.wantStart)
+		}
+		if got := test.date.EndOfDay(test.loc); !got.Equal(test.wantEnd) {
+			t.Errorf("%v.EndOfDay(%v) = %v, want %v", test.date, test.loc, got, test.wantEnd)
+		}
+		if got := DateOf(test.date.EndOfDay(test.loc)); got != test.date {
+			t.Errorf("DateOf(%v.EndOfDay(%v)) = %v", test.date, test.loc, got)
+		}
+	}
+}
+
 func TestDateIsValid(t *testing.T) {
 	for _, test := range []struct {
 		date Date
@@ -67,6 +184,68 @@ func TestDateIsValid(t *testing.T) {
 			t.Errorf("%#v: got %t, want %t", test.date, got, test.want)
 		}
 	}
+
+	// IsValid must agree with a round trip through time.Time.
+	for _, year := range []int{-401, -400, -1, 0, 1, 1900, 2000, 2015, 2016} {
+		for month := time.Month(-1); month <= 14; month++ {
+			for day := -1; day <= 33; day++ {
+				d := Date{year, month, day}
+				if got, want := d.IsValid(), DateOf(d.In(time.UTC)) == d; got != want {
+					t.Errorf("%#v: got %t, want %t", d, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		date Date
+		want error
+		msg  string
+	}{
+		{Date{2014, 7, 29}, nil, ""},
+		{Date{2000, 2, 29}, nil, ""},
+		{Date{-1, 1, 1}, nil, ""},
+		{Date{1, 0, 1}, ErrMonthOutOfRange, "civil.Date.Validate: month out of range: 0"},
+		{Date{2016, 13, 1}, ErrMonthOutOfRange, "civil.Date.Validate: month out of range: 13"},
+		{Date{}, ErrMonthOutOfRange, "civil.Date.Validate: month out of range: 0"},
+		{Date{1, 1, 0}, ErrDayOutOfRange, "civil.Date.Validate: day out of range: 0 for 0001-01, which has 31 days"},
+		{Date{2015, 2, 29}, ErrDayOutOfRange, "civil.Date.Validate: day out of range: 29 for 2015-02, which has 28 days"},
+		{Date{2016, 4, 31}, ErrDayOutOfRange, "civil.Date.Validate: day out of range: 31 for 2016-04, which has 30 days"},
+	} {
+		err := test.date.Validate()
+		if !errors.Is(err, test.want) {
+			t.Errorf("%#v.Validate(): got %v, want %v", test.date, err, test.want)
+		}
+		if err != nil && err.Error() != test.msg {
+			t.Errorf("%#v.Validate(): got %q, want %q", test.date, err.Error(), test.msg)
+		}
+		if got := err == nil; got != test.date.IsValid() {
+			t.Errorf("%#v.Validate(): got %v, but IsValid() is %t", test.date, err, test.date.IsValid())
+		}
+	}
+}
+
+func TestDateIsZero(t *testing.T) {
+	for _, test := range []struct {
+		date Date
+		want bool
+	}{
+		{Date{}, true},
+		{Date{0, 1, 1}, false},
+		{Date{1, 1, 1}, false},
+		{Date{0, 0, 1}, false},
+		{Date{2014, 7, 29}, false},
+	} {
+		if got := test.date.IsZero(); got != test.want {
+			t.Errorf("%#v.IsZero(): got %t, want %t", test.date, got, test.want)
+		}
+	}
+
+	if (Date{}).IsValid() {
+		t.Errorf("Date{}.IsValid(): got true, want false")
+	}
 }
 
 func TestParseDate(t *testing.T) {
@@ -93,6 +272,215 @@ func TestParseDate(t *testing.T) {
 	}
 }
 
+func TestParseDateFastPath(t *testing.T) {
+	// The fast path must agree with time.Parse for everything it accepts.
+	for _, s := range []string{
+		"2016-01-02",
+		"0003-02-04",
+		"0000-01-01",
+		"9999-12-31",
+		"2016-02-29",
+		"2015-02-29",
+		"1900-02-29",
+		"2000-02-29",
+		"2016-04-31",
+		"2016-00-10",
+		"2016-13-10",
+		"2016-01-00",
+		"2016-01-32",
+		"2016-1-02x",
+	} {
+		got, err := ParseDate(s)
+
+		want := Date{}
+		if t, err := time.Parse("2006-01-02", s); err == nil {
+			want = DateOf(t)
+		}
+
+		if got != want {
+			t.Errorf("ParseDate(%q) = %v, want %v", s, got, want)
+		}
+		if (err == nil) != (want != Date{}) {
+			t.Errorf("ParseDate(%q): got error %v", s, err)
+		}
+	}
+}
+
+func BenchmarkParseDate(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ParseDate("2016-01-02")
+	}
+}
+
+func TestParseDateErrors(t *testing.T) {
+	for _, test := range []struct {
+		str  string
+		want error
+		msg  string
+	}{
+		{"", ErrEmptyDate, `civil.ParseDate: empty date`},
+		{"999-01-26", ErrInvalidFormat, `civil.ParseDate: invalid date format: "999-01-26"`},
+		{"2016-01-02x", ErrInvalidFormat, `civil.ParseDate: invalid date format: "2016-01-02x"`},
+		{"2016/01/02", ErrInvalidFormat, `civil.ParseDate: invalid date format: "2016/01/02"`},
+		{"2016-13-02", ErrMonthOutOfRange, `civil.ParseDate: month out of range: "2016-13-02"`},
+		{"2015-02-29", ErrDayOutOfRange, `civil.ParseDate: day out of range: "2015-02-29"`},
+		{"2015-02-29T00:00:00Z", ErrDayOutOfRange, `civil.ParseDate: day out of range: "2015-02-29T00:00:00Z"`},
+		{"2015-13-01T00:00:00+10:00", ErrMonthOutOfRange, `civil.ParseDate: month out of range: "2015-13-01T00:00:00+10:00"`},
+		{"2015-02-29T00:00:00", ErrInvalidFormat, `civil.ParseDate: invalid date format: "2015-02-29T00:00:00"`},
+		{"2015-02-29x", ErrInvalidFormat, `civil.ParseDate: invalid date format: "2015-02-29x"`},
+	} {
+		_, err := ParseDate(test.str)
+		if !errors.Is(err, test.want) {
+			t.Errorf("ParseDate(%q): got error %v, want %v", test.str, err, test.want)
+		}
+		if err != nil && err.Error() != test.msg {
+			t.Errorf("ParseDate(%q): got error %q, want %q", test.str, err.Error(), test.msg)
+		}
+	}
+}
+
+func TestMustParseDate(t *testing.T) {
+	assert.Equal(t, Date{2016, 1, 2}, MustParseDate("2016-01-02"))
+	assert.Equal(t, Date{3, 2, 4}, MustParseDate("0003-02-04"))
+	assert.Panics(t, func() { MustParseDate("2016-01-02x") })
+	assert.Panics(t, func() { MustParseDate("") })
+}
+
+func TestParseDateLenient(t *testing.T) {
+	for _, test := range []struct {
+		str  string
+		want Date // if empty, expect an error
+	}{
+		{"2016-01-02", Date{2016, 1, 2}},
+		{"2016-1-2", Date{2016, 1, 2}},
+		{"2016-12-31", Date{2016, 12, 31}},
+		{"0003-02-04", Date{3, 2, 4}},
+		{"999-1-26", Date{999, 1, 26}},
+		{"3-2-4", Date{3, 2, 4}},
+		{"2016-2-29", Date{2016, 2, 29}},
+		{"2015-2-29", Date{}},
+		{"2016-13-1", Date{}},
+		{"2016-0-1", Date{}},
+		{"2016-1-0", Date{}},
+		{"2016-001-02", Date{}},
+		{"12016-1-2", Date{}},
+		{"2016--2", Date{}},
+		{"2016-1-2x", Date{}},
+		{"2016-+1-2", Date{}},
+		{"2016-1", Date{}},
+		{"", Date{}},
+	} {
+		got, err := ParseDateLenient(test.str)
+		if got != test.want {
+			t.Errorf("ParseDateLenient(%q) = %+v, want %+v", test.str, got, test.want)
+		}
+		if (err != nil) != (test.want == Date{}) {
+			t.Errorf("ParseDateLenient(%q): unexpected error state %v", test.str, err)
+		}
+	}
+}
+
+func TestParseDateCompact(t *testing.T) {
+	for _, test := range []struct {
+		str  string
+		want Date // if empty, expect an error
+	}{
+		{"20160102", Date{2016, 1, 2}},
+		{"20161231", Date{2016, 12, 31}},
+		{"00030204", Date{3, 2, 4}},
+		{"20160229", Date{2016, 2, 29}},
+		{"20150229", Date{}},
+		{"20161301", Date{}},
+		{"2016012", Date{}},
+		{"201601020", Date{}},
+		{"2016-1-2", Date{}},
+		{"+2016012", Date{}},
+		{"", Date{}},
+	} {
+		got, err := ParseDateCompact(test.str)
+		if got != test.want {
+			t.Errorf("ParseDateCompact(%q) = %+v, want %+v", test.str, got, test.want)
+		}
+		if (err != nil) != (test.want == Date{}) {
+			t.Errorf("ParseDateCompact(%q): unexpected error state %v", test.str, err)
+		}
+		if err == nil {
+			if s := got.FormatCompact(); s != test.str {
+				t.Errorf("%#v.FormatCompact() = %q, want %q", got, s, test.str)
+			}
+		}
+	}
+}
+
+func TestParseDateInLayout(t *testing.T) {
+	for _, test := range []struct {
+		layout, str string
+		want        Date // if empty, expect an error
+	}{
+		{"02/01/2006", "15/04/1987", Date{1987, 4, 15}},
+		{"01/02/2006", "04/15/1987", Date{1987, 4, 15}},
+		{"Jan 2, 2006", "Apr 15, 1987", Date{1987, 4, 15}},
+		{"2006-01-02 15:04", "1987-04-15 23:59", Date{1987, 4, 15}},
+		{"2006-01-02T15:04:05Z07:00", "1987-04-15T23:00:00-05:00", Date{1987, 4, 15}},
+		{"02/01/2006", "1987-04-15", Date{}},
+		{"02/01/2006", "", Date{}},
+	} {
+		got, err := ParseDateInLayout(test.layout, test.str)
+		if got != test.want {
+			t.Errorf("ParseDateInLayout(%q, %q) = %+v, want %+v", test.layout, test.str, got, test.want)
+		}
+		if (err != nil) != (test.want == Date{}) {
+			t.Errorf("ParseDateInLayout(%q, %q): unexpected error state %v", test.layout, test.str, err)
+		}
+		if err != nil {
+			var perr *time.ParseError
+			if !errors.As(err, &perr) {
+				t.Errorf("ParseDateInLayout(%q, %q): error %v doesn't wrap a *time.ParseError", test.layout, test.str, err)
+			}
+			if !strings.Contains(err.Error(), fmt.Sprintf("%q", test.str)) {
+				t.Errorf("ParseDateInLayout(%q, %q): error %q doesn't include the input", test.layout, test.str, err)
+			}
+		}
+	}
+}
+
+func TestParseDateAny(t *testing.T) {
+	for _, test := range []struct {
+		str     string
+		layouts []string
+		want    Date // if empty, expect an error
+	}{
+		{"2016-01-02", nil, Date{2016, 1, 2}},
+		{"2016-01-02T23:59:59.999Z", nil, Date{2016, 1, 2}},
+		{"02/01/2016", nil, Date{}},
+		{"02/01/2016", []string{"01/02/2006", "02/01/2006"}, Date{2016, 2, 1}},
+		{"13/01/2016", []string{"01/02/2006", "02/01/2006"}, Date{2016, 1, 13}},
+		{"Jan 2, 2016", []string{"01/02/2006", "Jan 2, 2006"}, Date{2016, 1, 2}},
+		{"2016-01-02", []string{"01/02/2006"}, Date{}},
+		{"", []string{"01/02/2006", "Jan 2, 2006"}, Date{}},
+	} {
+		got, err := ParseDateAny(test.str, test.layouts...)
+		if got != test.want {
+			t.Errorf("ParseDateAny(%q, %q) = %+v, want %+v", test.str, test.layouts, got, test.want)
+		}
+		if (err != nil) != (test.want == Date{}) {
+			t.Errorf("ParseDateAny(%q, %q): unexpected error state %v", test.str, test.layouts, err)
+		}
+		if err != nil {
+			for _, layout := range test.layouts {
+				if !strings.Contains(err.Error(), layout) {
+					t.Errorf("ParseDateAny(%q, %q): error %q doesn't mention layout %q", test.str, test.layouts, err, layout)
+				}
+			}
+			var pe *time.ParseError
+			if !errors.As(err, &pe) {
+				t.Errorf("ParseDateAny(%q, %q): error %q doesn't wrap a *time.ParseError", test.str, test.layouts, err)
+			}
+		}
+	}
+}
+
 func TestDateArithmetic(t *testing.T) {
 	for _, test := range []struct {
 		desc  string
@@ -152,6 +540,93 @@ func TestDateArithmetic(t *testing.T) {
 	}
 }
 
+func TestUnixDays(t *testing.T) {
+	for _, d := range []Date{
+		{1970, 1, 1},
+		{1969, 12, 31},
+		{2000, 2, 29},
+		{2100, 3, 1},
+		{999, 1, 26},
+		{101, 1, 1},
+		{1, 1, 1},
+		{0, 2, 29},
+		{-1, 12, 31},
+		{-400, 3, 1},
+		{-4713, 11, 24},
+		{275760, 9, 13},
+		{2016, 0, 1},   // month zero
+		{2016, 13, 1},  // month thirteen
+		{2016, -13, 1}, // negative month
+		{2016, 1, 32},  // day past the end of the month
+		{2016, 3, 0},   // day zero
+	} {
+		want := d.In(time.UTC).Unix() / 86400
+		if got := d.unixDays(); int64(got) != want {
+			t.Errorf("%#v.unixDays() = %d, want %d", d, got, want)
+		}
+		if got, want := dateOfUnixDays(d.unixDays()), DateOf(d.In(time.UTC)); got != want {
+			t.Errorf("dateOfUnixDays(%d) = %#v, want %#v", d.unixDays(), got, want)
+		}
+	}
+}
+
+func TestStringMatchesSprintf(t *testing.T) {
+	for _, d := range []Date{
+		{2016, 1, 2},
+		{999, 1, 26},
+		{3, 2, 4},
+		{0, 1, 1},
+		{-1, 12, 31},
+		{-12345, 6, 7},
+		{12345, 6, 7},
+		{math.MaxInt32, 12, 31},
+		{math.MinInt32, 1, 1},
+		{2016, 0, 0},
+		{2016, -1, -1},
+		{2016, 13, 32},
+	} {
+		if got, want := d.String(), fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day); got != want {
+			t.Errorf("%#v.String() = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	b.ReportAllocs()
+	d := Date{2014, 5, 9}
+	for i := 0; i < b.N; i++ {
+		_ = d.String()
+	}
+}
+
+func BenchmarkIsValid(b *testing.B) {
+	d := Date{2016, 2, 29}
+	for i := 0; i < b.N; i++ {
+		d.IsValid()
+	}
+}
+
+func BenchmarkAddMonths(b *testing.B) {
+	d := Date{2014, 1, 31}
+	for i := 0; i < b.N; i++ {
+		d.AddMonths(100000)
+	}
+}
+
+func BenchmarkAddDays(b *testing.B) {
+	d := Date{2014, 5, 9}
+	for i := 0; i < b.N; i++ {
+		d.AddDays(i % 1000)
+	}
+}
+
+func BenchmarkDaysSince(b *testing.B) {
+	d1, d2 := Date{2014, 5, 9}, Date{1987, 4, 15}
+	for i := 0; i < b.N; i++ {
+		d1.DaysSince(d2)
+	}
+}
+
 func TestAddDays(t *testing.T) {
 	for _, test := range []struct {
 		desc  string
@@ -208,6 +683,50 @@ func TestAddDays(t *testing.T) {
 	}
 }
 
+func TestAddWeeks(t *testing.T) {
+	for _, test := range []struct {
+		desc  string
+		start Date
+		end   Date
+		n     int
+	}{
+		{
+			desc:  "zero weeks noop",
+			start: Date{2014, 5, 9},
+			end:   Date{2014, 5, 9},
+			n:     0,
+		},
+		{
+			desc:  "positive weeks",
+			start: Date{2014, 5, 9},
+			end:   Date{2014, 5, 23},
+			n:     2,
+		},
+		{
+			desc:  "crossing a year boundary",
+			start: Date{2014, 12, 29},
+			end:   Date{2015, 1, 5},
+			n:     1,
+		},
+		{
+			desc:  "negative weeks crossing a year boundary",
+			start: Date{2015, 1, 5},
+			end:   Date{2014, 12, 22},
+			n:     -2,
+		},
+		{
+			desc:  "crossing a leap day",
+			start: Date{2016, 2, 25},
+			end:   Date{2016, 3, 3},
+			n:     1,
+		},
+	} {
+		if got := test.start.AddWeeks(test.n); got != test.end {
+			t.Errorf("[%s] %#v.AddWeeks(%v) = %#v, want %#v", test.desc, test.start, test.n, got, test.end)
+		}
+	}
+}
+
 func TestAddMonths(t *testing.T) {
 	for _, test := range []struct {
 		desc  string
@@ -263,6 +782,30 @@ func TestAddMonths(t *testing.T) {
 			end:   Date{2012, 2, 29},
 			n:     1,
 		},
+		{
+			desc:  "exactly one year back",
+			start: Date{2016, 1, 15},
+			end:   Date{2015, 1, 15},
+			n:     -12,
+		},
+		{
+			desc:  "just over one year back",
+			start: Date{2016, 1, 15},
+			end:   Date{2014, 12, 15},
+			n:     -13,
+		},
+		{
+			desc:  "large n",
+			start: Date{2016, 1, 31},
+			end:   Date{10349, 5, 31},
+			n:     100000,
+		},
+		{
+			desc:  "large negative n",
+			start: Date{2016, 1, 31},
+			end:   Date{-6318, 9, 30},
+			n:     -100000,
+		},
 	} {
 		if got := test.start.AddMonths(test.n); got != test.end {
 			t.Errorf("[%s] %#v.AddMonths(%v) = %#v, want %#v", test.desc, test.start, test.n, got, test.end)
@@ -270,6 +813,55 @@ func TestAddMonths(t *testing.T) {
 	}
 }
 
+func TestAddDate(t *testing.T) {
+	for _, test := range []struct {
+		desc                string
+		start               Date
+		end                 Date
+		years, months, days int
+	}{
+		{
+			desc:  "zero noop",
+			start: Date{2014, 5, 9},
+			end:   Date{2014, 5, 9},
+		},
+		{
+			desc:  "years months and days",
+			start: Date{2014, 5, 9},
+			end:   Date{2015, 7, 12},
+			years: 1, months: 2, days: 3,
+		},
+		{
+			desc:  "negative everything",
+			start: Date{2014, 5, 9},
+			end:   Date{2013, 3, 6},
+			years: -1, months: -2, days: -3,
+		},
+		{
+			desc:  "months clamp before days are added",
+			start: Date{2014, 1, 31},
+			end:   Date{2014, 3, 1},
+			years: 0, months: 1, days: 1,
+		},
+		{
+			desc:  "years and months clamp once",
+			start: Date{2012, 2, 29},
+			end:   Date{2013, 3, 29},
+			years: 1, months: 1, days: 0,
+		},
+		{
+			desc:  "leap day plus one year",
+			start: Date{2012, 2, 29},
+			end:   Date{2013, 2, 28},
+			years: 1, months: 0, days: 0,
+		},
+	} {
+		if got := test.start.AddDate(test.years, test.months, test.days); got != test.end {
+			t.Errorf("[%s] %#v.AddDate(%d, %d, %d) = %#v, want %#v", test.desc, test.start, test.years, test.months, test.days, got, test.end)
+		}
+	}
+}
+
 func TestSetDayClamped(t *testing.T) {
 	for _, test := range []struct {
 		desc          string
@@ -301,12 +893,177 @@ func TestSetDayClamped(t *testing.T) {
 	}
 }
 
-type comparisonCase struct {
-	d1, d2            Date
-	before, after, on bool
+func TestSetMonthClamped(t *testing.T) {
+	for _, test := range []struct {
+		desc          string
+		input, output Date
+		month         time.Month
+	}{
+		{
+			desc:   "no clamping",
+			input:  Date{2014, 1, 15},
+			output: Date{2014, 6, 15},
+			month:  time.June,
+		},
+		{
+			desc:   "february (normal)",
+			input:  Date{2011, 1, 31},
+			output: Date{2011, 2, 28},
+			month:  time.February,
+		},
+		{
+			desc:   "february (leap)",
+			input:  Date{2012, 1, 31},
+			output: Date{2012, 2, 29},
+			month:  time.February,
+		},
+		{
+			desc:   "thirty days",
+			input:  Date{2012, 12, 31},
+			output: Date{2012, 11, 30},
+			month:  time.November,
+		},
+	} {
+		if got := test.input.SetMonthClamped(test.month); got != test.output {
+			t.Errorf("[%s] %#v.SetMonthClamped(%v) = %#v, want %#v", test.desc, test.input, test.month, got, test.output)
+		}
+	}
 }
 
-var comparisonCases = []comparisonCase{
+func TestWithYearMonthDay(t *testing.T) {
+	for _, test := range []struct {
+		desc string
+		got  Date
+		want Date
+	}{
+		{"year", Date{2016, 3, 15}.WithYear(2020), Date{2020, 3, 15}},
+		{"year from leap day", Date{2016, 2, 29}.WithYear(2017), Date{2017, 2, 28}},
+		{"year to leap year", Date{2016, 2, 29}.WithYear(2020), Date{2020, 2, 29}},
+		{"month", Date{2016, 3, 15}.WithMonth(time.July), Date{2016, 7, 15}},
+		{"month clamped", Date{2016, 1, 31}.WithMonth(time.February), Date{2016, 2, 29}},
+		{"month clamped (normal)", Date{2015, 1, 31}.WithMonth(time.February), Date{2015, 2, 28}},
+		{"month clamped to 30", Date{2016, 3, 31}.WithMonth(time.April), Date{2016, 4, 30}},
+		{"day", Date{2016, 3, 15}.WithDay(1), Date{2016, 3, 1}},
+		{"day clamped", Date{2016, 4, 15}.WithDay(31), Date{2016, 4, 30}},
+		{"chained", Date{2016, 1, 31}.WithYear(2015).WithMonth(time.February).WithDay(14), Date{2015, 2, 14}},
+	} {
+		if test.got != test.want {
+			t.Errorf("[%s] got %#v, want %#v", test.desc, test.got, test.want)
+		}
+	}
+}
+
+func TestMidpoint(t *testing.T) {
+	for _, test := range []struct {
+		desc string
+		a, b Date
+		want Date
+	}{
+		{
+			desc: "same day",
+			a:    Date{2016, 1, 1},
+			b:    Date{2016, 1, 1},
+			want: Date{2016, 1, 1},
+		},
+		{
+			desc: "even number of days",
+			a:    Date{2016, 1, 1},
+			b:    Date{2016, 1, 5},
+			want: Date{2016, 1, 3},
+		},
+		{
+			desc: "odd number of days rounds down",
+			a:    Date{2016, 1, 1},
+			b:    Date{2016, 1, 4},
+			want: Date{2016, 1, 2},
+		},
+		{
+			desc: "adjacent days",
+			a:    Date{2016, 1, 1},
+			b:    Date{2016, 1, 2},
+			want: Date{2016, 1, 1},
+		},
+		{
+			desc: "crossing a year boundary",
+			a:    Date{2015, 12, 1},
+			b:    Date{2016, 1, 31},
+			want: Date{2015, 12, 31},
+		},
+		{
+			desc: "before the unix epoch",
+			a:    Date{101, 1, 1},
+			b:    Date{102, 1, 1},
+			want: Date{101, 7, 2},
+		},
+	} {
+		if got := Midpoint(test.a, test.b); got != test.want {
+			t.Errorf("[%s] Midpoint(%#v, %#v) = %#v, want %#v", test.desc, test.a, test.b, got, test.want)
+		}
+		if got := Midpoint(test.b, test.a); got != test.want {
+			t.Errorf("[%s] Midpoint(%#v, %#v) = %#v, want %#v", test.desc, test.b, test.a, got, test.want)
+		}
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	for _, test := range []struct {
+		dates    []Date
+		min, max Date
+	}{
+		{nil, Date{}, Date{}},
+		{[]Date{{2016, 1, 1}}, Date{2016, 1, 1}, Date{2016, 1, 1}},
+		{[]Date{{2016, 1, 1}, {2015, 12, 31}}, Date{2015, 12, 31}, Date{2016, 1, 1}},
+		{[]Date{{2016, 3, 1}, {999, 1, 26}, {2016, 3, 2}, {2016, 1, 1}}, Date{999, 1, 26}, Date{2016, 3, 2}},
+		{[]Date{{-1, 1, 1}, {1, 1, 1}}, Date{-1, 1, 1}, Date{1, 1, 1}},
+	} {
+		if got := Min(test.dates...); got != test.min {
+			t.Errorf("Min(%v) = %#v, want %#v", test.dates, got, test.min)
+		}
+		if got := Max(test.dates...); got != test.max {
+			t.Errorf("Max(%v) = %#v, want %#v", test.dates, got, test.max)
+		}
+		if got, ok := Earliest(test.dates); got != test.min || ok != (len(test.dates) > 0) {
+			t.Errorf("Earliest(%v) = %#v, %t, want %#v, %t", test.dates, got, ok, test.min, len(test.dates) > 0)
+		}
+		if got, ok := Latest(test.dates); got != test.max || ok != (len(test.dates) > 0) {
+			t.Errorf("Latest(%v) = %#v, %t, want %#v, %t", test.dates, got, ok, test.max, len(test.dates) > 0)
+		}
+	}
+
+	if got, ok := Earliest([]Date{{}}); got != (Date{}) || !ok {
+		t.Errorf("Earliest([]Date{{}}) = %#v, %t, want the zero Date, true", got, ok)
+	}
+}
+
+func TestClamp(t *testing.T) {
+	for _, test := range []struct {
+		d, lo, hi Date
+		want      Date
+	}{
+		{Date{2016, 1, 15}, Date{2016, 1, 1}, Date{2016, 1, 31}, Date{2016, 1, 15}},
+		{Date{2015, 12, 31}, Date{2016, 1, 1}, Date{2016, 1, 31}, Date{2016, 1, 1}},
+		{Date{2016, 2, 1}, Date{2016, 1, 1}, Date{2016, 1, 31}, Date{2016, 1, 31}},
+		{Date{2016, 1, 1}, Date{2016, 1, 1}, Date{2016, 1, 31}, Date{2016, 1, 1}},
+		{Date{2016, 1, 31}, Date{2016, 1, 1}, Date{2016, 1, 31}, Date{2016, 1, 31}},
+		{Date{2016, 2, 1}, Date{2016, 1, 31}, Date{2016, 1, 1}, Date{2016, 1, 31}},
+		{Date{2015, 12, 1}, Date{2016, 1, 31}, Date{2016, 1, 1}, Date{2016, 1, 1}},
+	} {
+		if got := test.d.Clamp(test.lo, test.hi); got != test.want {
+			t.Errorf("%v.Clamp(%v, %v) = %v, want %v", test.d, test.lo, test.hi, got, test.want)
+		}
+		r := DateRange{test.lo, test.hi}
+		if got := test.d.ClampToRange(r); got != test.want {
+			t.Errorf("%v.ClampToRange(%v) = %v, want %v", test.d, r, got, test.want)
+		}
+	}
+}
+
+type comparisonCase struct {
+	d1, d2            Date
+	before, after, on bool
+}
+
+var comparisonCases = []comparisonCase{
 	{Date{2016, 1, 1}, Date{2016, 1, 1}, false, false, true},
 	{Date{2016, 12, 31}, Date{2017, 1, 1}, true, false, false},
 	{Date{2017, 1, 1}, Date{2016, 12, 31}, false, true, false},
@@ -324,6 +1081,45 @@ var comparisonCases = []comparisonCase{
 	{Date{2017, 2, 2}, Date{2016, 1, 1}, false, true, false},
 }
 
+func TestBetween(t *testing.T) {
+	start, end := Date{2016, 1, 10}, Date{2016, 1, 20}
+
+	for _, test := range []struct {
+		d                                      Date
+		inclusive, exclusive, halfOpen, halfOn bool
+	}{
+		{Date{2016, 1, 9}, false, false, false, false},
+		{Date{2016, 1, 10}, true, false, true, false},
+		{Date{2016, 1, 15}, true, true, true, true},
+		{Date{2016, 1, 20}, true, false, false, true},
+		{Date{2016, 1, 21}, false, false, false, false},
+		{Date{2015, 1, 15}, false, false, false, false},
+	} {
+		if got := test.d.BetweenInclusive(start, end); got != test.inclusive {
+			t.Errorf("%v.BetweenInclusive(%v, %v): got %t, want %t", test.d, start, end, got, test.inclusive)
+		}
+		if got := test.d.BetweenExclusive(start, end); got != test.exclusive {
+			t.Errorf("%v.BetweenExclusive(%v, %v): got %t, want %t", test.d, start, end, got, test.exclusive)
+		}
+		if got := test.d.Between(start, end, true, false); got != test.halfOpen {
+			t.Errorf("%v.Between(%v, %v, true, false): got %t, want %t", test.d, start, end, got, test.halfOpen)
+		}
+		if got := test.d.Between(start, end, false, true); got != test.halfOn {
+			t.Errorf("%v.Between(%v, %v, false, true): got %t, want %t", test.d, start, end, got, test.halfOn)
+		}
+		if test.d.BetweenInclusive(end, start) {
+			t.Errorf("%v.BetweenInclusive(%v, %v): got true, want false", test.d, end, start)
+		}
+	}
+
+	if !start.BetweenInclusive(start, start) {
+		t.Errorf("%v.BetweenInclusive(%v, %v): got false, want true", start, start, start)
+	}
+	if start.Between(start, start, true, false) {
+		t.Errorf("%v.Between(%v, %v, true, false): got true, want false", start, start, start)
+	}
+}
+
 func TestDateOn(t *testing.T) {
 	for _, test := range comparisonCases {
 		t.Run(fmt.Sprintf("%v.On(%v)", test.d1, test.d2), func(t *testing.T) {
@@ -334,6 +1130,16 @@ func TestDateOn(t *testing.T) {
 	}
 }
 
+func TestDateEqual(t *testing.T) {
+	for _, test := range comparisonCases {
+		t.Run(fmt.Sprintf("%v.Equal(%v)", test.d1, test.d2), func(t *testing.T) {
+			if got := test.d1.Equal(test.d2); got != test.on {
+				t.Errorf("%v.Equal(%v): got %t, want %t", test.d1, test.d2, got, test.on)
+			}
+		})
+	}
+}
+
 func TestDateBefore(t *testing.T) {
 	for _, test := range comparisonCases {
 		t.Run(fmt.Sprintf("%v.Before(%v)", test.d1, test.d2), func(t *testing.T) {
@@ -374,6 +1180,177 @@ func TestDateAfterOrOn(t *testing.T) {
 	}
 }
 
+func TestDateCompare(t *testing.T) {
+	for _, test := range comparisonCases {
+		t.Run(fmt.Sprintf("%v.Compare(%v)", test.d1, test.d2), func(t *testing.T) {
+			want := 0
+			if test.before {
+				want = -1
+			} else if test.after {
+				want = +1
+			}
+			if got := test.d1.Compare(test.d2); got != want {
+				t.Errorf("%v.Compare(%v): got %d, want %d", test.d1, test.d2, got, want)
+			}
+		})
+	}
+}
+
+func TestIsSamePeriod(t *testing.T) {
+	for _, test := range []struct {
+		d1, d2                               Date
+		year, month, quarter, weekMo, weekSu bool
+	}{
+		{Date{2016, 1, 1}, Date{2016, 1, 1}, true, true, true, true, true},
+		{Date{2016, 1, 1}, Date{2016, 1, 31}, true, true, true, false, false},
+		{Date{2016, 1, 1}, Date{2016, 3, 31}, true, false, true, false, false},
+		{Date{2016, 3, 31}, Date{2016, 4, 1}, true, false, false, true, true},
+		{Date{2016, 1, 15}, Date{2015, 1, 15}, false, false, false, false, false},
+		{Date{2015, 12, 31}, Date{2016, 1, 1}, false, false, false, true, true},
+		{Date{2016, 3, 6}, Date{2016, 3, 7}, true, true, true, false, true},
+		{Date{2016, 3, 5}, Date{2016, 3, 6}, true, true, true, true, false},
+	} {
+		for _, pair := range [][2]Date{{test.d1, test.d2}, {test.d2, test.d1}} {
+			a, b := pair[0], pair[1]
+			if got := a.IsSameYear(b); got != test.year {
+				t.Errorf("%v.IsSameYear(%v): got %t, want %t", a, b, got, test.year)
+			}
+			if got := a.IsSameMonth(b); got != test.month {
+				t.Errorf("%v.IsSameMonth(%v): got %t, want %t", a, b, got, test.month)
+			}
+			if got := a.IsSameQuarter(b); got != test.quarter {
+				t.Errorf("%v.IsSameQuarter(%v): got %t, want %t", a, b, got, test.quarter)
+			}
+			if got := a.IsSameWeek(b, time.Monday); got != test.weekMo {
+				t.Errorf("%v.IsSameWeek(%v, Monday): got %t, want %t", a, b, got, test.weekMo)
+			}
+			if got := a.IsSameWeek(b, time.Sunday); got != test.weekSu {
+				t.Errorf("%v.IsSameWeek(%v, Sunday): got %t, want %t", a, b, got, test.weekSu)
+			}
+		}
+	}
+
+	for _, test := range []struct {
+		d1, d2    Date
+		weekStart time.Weekday
+		want      bool
+	}{
+		{Date{2015, 12, 31}, Date{2016, 1, 1}, time.Thursday, true},
+		{Date{2015, 12, 31}, Date{2016, 1, 1}, time.Friday, false},
+		{Date{2015, 12, 31}, Date{2016, 1, 1}, time.Saturday, true},
+		{Date{2016, 1, 1}, Date{2016, 1, 7}, time.Friday, true},
+		{Date{2016, 1, 1}, Date{2016, 1, 8}, time.Friday, false},
+	} {
+		if got := test.d1.IsSameWeek(test.d2, test.weekStart); got != test.want {
+			t.Errorf("%v.IsSameWeek(%v, %v): got %t, want %t", test.d1, test.d2, test.weekStart, got, test.want)
+		}
+	}
+}
+
+func TestAge(t *testing.T) {
+	for _, test := range []struct {
+		desc      string
+		birth, on Date
+		want      int
+	}{
+		{"day of birth", Date{1987, 4, 15}, Date{1987, 4, 15}, 0},
+		{"day before birthday", Date{1987, 4, 15}, Date{2016, 4, 14}, 28},
+		{"birthday", Date{1987, 4, 15}, Date{2016, 4, 15}, 29},
+		{"month before birthday", Date{1987, 4, 15}, Date{2016, 3, 20}, 28},
+		{"month after birthday", Date{1987, 4, 15}, Date{2016, 5, 1}, 29},
+		{"leap day birthday on feb 28", Date{2000, 2, 29}, Date{2015, 2, 28}, 14},
+		{"leap day birthday on mar 1", Date{2000, 2, 29}, Date{2015, 3, 1}, 15},
+		{"leap day birthday on leap day", Date{2000, 2, 29}, Date{2016, 2, 29}, 16},
+		{"leap day birthday on feb 28 of leap year", Date{2000, 2, 29}, Date{2016, 2, 28}, 15},
+		{"before birth", Date{2000, 6, 1}, Date{1999, 7, 1}, 0},
+		{"years before birth", Date{2000, 6, 1}, Date{1990, 6, 1}, -10},
+		{"years and a bit before birth", Date{2000, 6, 1}, Date{1990, 5, 31}, -10},
+	} {
+		if got := test.birth.Age(test.on); got != test.want {
+			t.Errorf("[%s] %v.Age(%v) = %d, want %d", test.desc, test.birth, test.on, got, test.want)
+		}
+	}
+}
+
+func TestNextAnniversary(t *testing.T) {
+	for _, test := range []struct {
+		desc     string
+		d, after Date
+		policy   LeapDayPolicy
+		want     Date
+	}{
+		{"later in the year", Date{1987, 4, 15}, Date{2016, 1, 1}, LeapDayFeb28, Date{2016, 4, 15}},
+		{"on the anniversary", Date{1987, 4, 15}, Date{2016, 4, 15}, LeapDayFeb28, Date{2017, 4, 15}},
+		{"earlier in the year", Date{1987, 4, 15}, Date{2016, 6, 1}, LeapDayFeb28, Date{2017, 4, 15}},
+		{"after is the date itself", Date{1987, 4, 15}, Date{1987, 4, 15}, LeapDayFeb28, Date{1988, 4, 15}},
+		{"after is before the date", Date{1987, 4, 15}, Date{1950, 1, 1}, LeapDayFeb28, Date{1988, 4, 15}},
+		{"leap day, feb 28 policy", Date{2000, 2, 29}, Date{2015, 1, 1}, LeapDayFeb28, Date{2015, 2, 28}},
+		{"leap day, mar 1 policy", Date{2000, 2, 29}, Date{2015, 1, 1}, LeapDayMar1, Date{2015, 3, 1}},
+		{"leap day, feb 28 policy on feb 28", Date{2000, 2, 29}, Date{2015, 2, 28}, LeapDayFeb28, Date{2016, 2, 29}},
+		{"leap day, mar 1 policy on feb 28", Date{2000, 2, 29}, Date{2015, 2, 28}, LeapDayMar1, Date{2015, 3, 1}},
+		{"leap day, mar 1 policy on mar 1", Date{2000, 2, 29}, Date{2015, 3, 1}, LeapDayMar1, Date{2016, 2, 29}},
+		{"leap day, leap year", Date{2000, 2, 29}, Date{2016, 2, 1}, LeapDayMar1, Date{2016, 2, 29}},
+		{"leap day, feb 28 of leap year", Date{2000, 2, 29}, Date{2016, 2, 28}, LeapDayFeb28, Date{2016, 2, 29}},
+		{"leap day, after leap day", Date{2000, 2, 29}, Date{2016, 2, 29}, LeapDayMar1, Date{2017, 3, 1}},
+		{"leap day, across century", Date{2096, 2, 29}, Date{2100, 1, 1}, LeapDayMar1, Date{2100, 3, 1}},
+		{"mar 1 is unaffected by policy", Date{2000, 3, 1}, Date{2015, 2, 28}, LeapDayMar1, Date{2015, 3, 1}},
+	} {
+		if got := test.d.NextAnniversaryWith(test.after, test.policy); got != test.want {
+			t.Errorf("[%s] %v.NextAnniversaryWith(%v, %v) = %v, want %v", test.desc, test.d, test.after, test.policy, got, test.want)
+		}
+		if test.policy == LeapDayFeb28 {
+			if got := test.d.NextAnniversary(test.after); got != test.want {
+				t.Errorf("[%s] %v.NextAnniversary(%v) = %v, want %v", test.desc, test.d, test.after, got, test.want)
+			}
+		}
+	}
+}
+
+func TestYearsUntil(t *testing.T) {
+	for _, test := range []struct {
+		d, other Date
+		want     int
+	}{
+		{Date{2016, 1, 1}, Date{2016, 1, 1}, 0},
+		{Date{2016, 1, 1}, Date{2016, 12, 31}, 0},
+		{Date{2016, 1, 1}, Date{2017, 1, 1}, 1},
+		{Date{2016, 6, 15}, Date{2026, 6, 14}, 9},
+		{Date{2016, 6, 15}, Date{2026, 6, 15}, 10},
+		{Date{2012, 2, 29}, Date{2013, 2, 28}, 0},
+		{Date{2012, 2, 29}, Date{2013, 3, 1}, 1},
+		{Date{2012, 2, 29}, Date{2016, 2, 28}, 3},
+		{Date{2017, 1, 1}, Date{2016, 1, 2}, 0},
+		{Date{2017, 1, 1}, Date{2016, 1, 1}, -1},
+		{Date{2026, 6, 15}, Date{2016, 6, 16}, -9},
+	} {
+		if got := test.d.YearsUntil(test.other); got != test.want {
+			t.Errorf("%v.YearsUntil(%v) = %d, want %d", test.d, test.other, got, test.want)
+		}
+		if got := test.d.Age(test.other); got != test.want {
+			t.Errorf("%v.Age(%v) = %d, want %d", test.d, test.other, got, test.want)
+		}
+	}
+}
+
+func TestWeeksUntil(t *testing.T) {
+	for _, test := range []struct {
+		d, other Date
+		want     int
+	}{
+		{Date{2016, 1, 1}, Date{2016, 1, 1}, 0},
+		{Date{2016, 1, 1}, Date{2016, 1, 7}, 0},
+		{Date{2016, 1, 1}, Date{2016, 1, 8}, 1},
+		{Date{2015, 12, 25}, Date{2016, 1, 8}, 2},
+		{Date{2016, 1, 1}, Date{2017, 1, 1}, 52},
+		{Date{2016, 1, 8}, Date{2016, 1, 2}, 0},
+		{Date{2016, 1, 8}, Date{2016, 1, 1}, -1},
+	} {
+		if got := test.d.WeeksUntil(test.other); got != test.want {
+			t.Errorf("%v.WeeksUntil(%v) = %d, want %d", test.d, test.other, got, test.want)
+		}
+	}
+}
+
 type firstLastCase struct {
 	d               Date
 	first, last     int
@@ -413,6 +1390,195 @@ func TestLastOfMonth(t *testing.T) {
 	}
 }
 
+func TestStartOfMonth(t *testing.T) {
+	for _, test := range firstLastCases {
+		t.Run(fmt.Sprintf("%v.StartOfMonth()", test.d), func(t *testing.T) {
+			want := Date{test.d.Year, test.d.Month, test.first}
+			if got := test.d.StartOfMonth(); got != want {
+				t.Errorf("%v.StartOfMonth(): got %v, want %v", test.d, got, want)
+			}
+		})
+	}
+}
+
+func TestEndOfMonth(t *testing.T) {
+	for _, test := range firstLastCases {
+		t.Run(fmt.Sprintf("%v.EndOfMonth()", test.d), func(t *testing.T) {
+			want := Date{test.d.Year, test.d.Month, test.last}
+			if got := test.d.EndOfMonth(); got != want {
+				t.Errorf("%v.EndOfMonth(): got %v, want %v", test.d, got, want)
+			}
+		})
+	}
+}
+
+func TestStartEndOfWeek(t *testing.T) {
+	for _, test := range []struct {
+		d          Date
+		weekStart  time.Weekday
+		start, end Date
+	}{
+		{Date{2016, 3, 2}, time.Monday, Date{2016, 2, 29}, Date{2016, 3, 6}},
+		{Date{2016, 2, 29}, time.Monday, Date{2016, 2, 29}, Date{2016, 3, 6}},
+		{Date{2016, 3, 6}, time.Monday, Date{2016, 2, 29}, Date{2016, 3, 6}},
+		{Date{2016, 3, 6}, time.Sunday, Date{2016, 3, 6}, Date{2016, 3, 12}},
+		{Date{2016, 3, 2}, time.Sunday, Date{2016, 2, 28}, Date{2016, 3, 5}},
+		{Date{2016, 1, 1}, time.Monday, Date{2015, 12, 28}, Date{2016, 1, 3}},
+		{Date{2016, 12, 31}, time.Saturday, Date{2016, 12, 31}, Date{2017, 1, 6}},
+	} {
+		if got := test.d.StartOfWeek(test.weekStart); got != test.start {
+			t.Errorf("%v.StartOfWeek(%v): got %v, want %v", test.d, test.weekStart, got, test.start)
+		}
+		if got := test.d.EndOfWeek(test.weekStart); got != test.end {
+			t.Errorf("%v.EndOfWeek(%v): got %v, want %v", test.d, test.weekStart, got, test.end)
+		}
+	}
+}
+
+func TestStartEndOfYear(t *testing.T) {
+	for _, test := range []struct {
+		d          Date
+		start, end Date
+	}{
+		{Date{2016, 2, 29}, Date{2016, 1, 1}, Date{2016, 12, 31}},
+		{Date{2015, 6, 15}, Date{2015, 1, 1}, Date{2015, 12, 31}},
+		{Date{2015, 1, 1}, Date{2015, 1, 1}, Date{2015, 12, 31}},
+		{Date{2015, 12, 31}, Date{2015, 1, 1}, Date{2015, 12, 31}},
+		{Date{0, 7, 4}, Date{0, 1, 1}, Date{0, 12, 31}},
+		{Date{-1, 7, 4}, Date{-1, 1, 1}, Date{-1, 12, 31}},
+	} {
+		if got := test.d.StartOfYear(); got != test.start {
+			t.Errorf("%v.StartOfYear(): got %v, want %v", test.d, got, test.start)
+		}
+		if got := test.d.EndOfYear(); got != test.end {
+			t.Errorf("%v.EndOfYear(): got %v, want %v", test.d, got, test.end)
+		}
+	}
+}
+
+func TestStartEndOfQuarter(t *testing.T) {
+	for _, test := range []struct {
+		d          Date
+		yearStart  time.Month
+		start, end Date
+	}{
+		{Date{2016, 1, 1}, time.January, Date{2016, 1, 1}, Date{2016, 3, 31}},
+		{Date{2016, 2, 29}, time.January, Date{2016, 1, 1}, Date{2016, 3, 31}},
+		{Date{2016, 3, 31}, time.January, Date{2016, 1, 1}, Date{2016, 3, 31}},
+		{Date{2016, 4, 1}, time.January, Date{2016, 4, 1}, Date{2016, 6, 30}},
+		{Date{2016, 6, 30}, time.January, Date{2016, 4, 1}, Date{2016, 6, 30}},
+		{Date{2016, 8, 15}, time.January, Date{2016, 7, 1}, Date{2016, 9, 30}},
+		{Date{2016, 12, 31}, time.January, Date{2016, 10, 1}, Date{2016, 12, 31}},
+		{Date{2016, 4, 1}, time.April, Date{2016, 4, 1}, Date{2016, 6, 30}},
+		{Date{2016, 3, 31}, time.April, Date{2016, 1, 1}, Date{2016, 3, 31}},
+		{Date{2016, 2, 15}, time.February, Date{2016, 2, 1}, Date{2016, 4, 30}},
+		{Date{2016, 1, 15}, time.February, Date{2015, 11, 1}, Date{2016, 1, 31}},
+		{Date{2016, 12, 15}, time.November, Date{2016, 11, 1}, Date{2017, 1, 31}},
+	} {
+		if test.yearStart == time.January {
+			if got := test.d.StartOfQuarter(); got != test.start {
+				t.Errorf("%v.StartOfQuarter(): got %v, want %v", test.d, got, test.start)
+			}
+			if got := test.d.EndOfQuarter(); got != test.end {
+				t.Errorf("%v.EndOfQuarter(): got %v, want %v", test.d, got, test.end)
+			}
+		}
+		if got := test.d.StartOfFiscalQuarter(test.yearStart); got != test.start {
+			t.Errorf("%v.StartOfFiscalQuarter(%v): got %v, want %v", test.d, test.yearStart, got, test.start)
+		}
+		if got := test.d.EndOfFiscalQuarter(test.yearStart); got != test.end {
+			t.Errorf("%v.EndOfFiscalQuarter(%v): got %v, want %v", test.d, test.yearStart, got, test.end)
+		}
+	}
+}
+
+func TestMonthGrid(t *testing.T) {
+	grid := Date{2016, 2, 15}.MonthGrid(time.Monday)
+	assert.Equal(t, [][]Date{
+		{{2016, 2, 1}, {2016, 2, 2}, {2016, 2, 3}, {2016, 2, 4}, {2016, 2, 5}, {2016, 2, 6}, {2016, 2, 7}},
+		{{2016, 2, 8}, {2016, 2, 9}, {2016, 2, 10}, {2016, 2, 11}, {2016, 2, 12}, {2016, 2, 13}, {2016, 2, 14}},
+		{{2016, 2, 15}, {2016, 2, 16}, {2016, 2, 17}, {2016, 2, 18}, {2016, 2, 19}, {2016, 2, 20}, {2016, 2, 21}},
+		{{2016, 2, 22}, {2016, 2, 23}, {2016, 2, 24}, {2016, 2, 25}, {2016, 2, 26}, {2016, 2, 27}, {2016, 2, 28}},
+		{{2016, 2, 29}, {2016, 3, 1}, {2016, 3, 2}, {2016, 3, 3}, {2016, 3, 4}, {2016, 3, 5}, {2016, 3, 6}},
+	}, grid)
+
+	for _, test := range []struct {
+		d           Date
+		weekStart   time.Weekday
+		rows        int
+		first, last Date
+	}{
+		{Date{2015, 2, 1}, time.Sunday, 4, Date{2015, 2, 1}, Date{2015, 2, 28}},
+		{Date{2015, 2, 1}, time.Monday, 5, Date{2015, 1, 26}, Date{2015, 3, 1}},
+		{Date{2016, 1, 31}, time.Monday, 5, Date{2015, 12, 28}, Date{2016, 1, 31}},
+		{Date{2016, 1, 31}, time.Sunday, 6, Date{2015, 12, 27}, Date{2016, 2, 6}},
+		{Date{2016, 10, 1}, time.Sunday, 6, Date{2016, 9, 25}, Date{2016, 11, 5}},
+	} {
+		grid := test.d.MonthGrid(test.weekStart)
+		if len(grid) != test.rows {
+			t.Errorf("%v.MonthGrid(%v): got %d rows, want %d", test.d, test.weekStart, len(grid), test.rows)
+			continue
+		}
+		if got := grid[0][0]; got != test.first {
+			t.Errorf("%v.MonthGrid(%v): got first date %v, want %v", test.d, test.weekStart, got, test.first)
+		}
+		if got := grid[len(grid)-1][6]; got != test.last {
+			t.Errorf("%v.MonthGrid(%v): got last date %v, want %v", test.d, test.weekStart, got, test.last)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	for _, test := range []struct {
+		d         Date
+		unit      Unit
+		weekStart time.Weekday
+		want      Date
+	}{
+		{Date{2016, 3, 15}, UnitDay, time.Monday, Date{2016, 3, 15}},
+		{Date{2016, 3, 15}, UnitWeek, time.Monday, Date{2016, 3, 14}},
+		{Date{2016, 3, 14}, UnitWeek, time.Monday, Date{2016, 3, 14}},
+		{Date{2016, 3, 13}, UnitWeek, time.Monday, Date{2016, 3, 7}},
+		{Date{2016, 1, 1}, UnitWeek, time.Monday, Date{2015, 12, 28}},
+		{Date{2016, 3, 15}, UnitWeek, time.Sunday, Date{2016, 3, 13}},
+		{Date{2016, 3, 13}, UnitWeek, time.Sunday, Date{2016, 3, 13}},
+		{Date{2016, 3, 15}, UnitMonth, time.Monday, Date{2016, 3, 1}},
+		{Date{2016, 3, 15}, UnitMonth, time.Sunday, Date{2016, 3, 1}},
+		{Date{2016, 3, 15}, UnitQuarter, time.Monday, Date{2016, 1, 1}},
+		{Date{2016, 8, 15}, UnitQuarter, time.Monday, Date{2016, 7, 1}},
+		{Date{2016, 3, 15}, UnitYear, time.Monday, Date{2016, 1, 1}},
+	} {
+		if got := test.d.Truncate(test.unit, test.weekStart); got != test.want {
+			t.Errorf("%v.Truncate(%d, %v): got %v, want %v", test.d, test.unit, test.weekStart, got, test.want)
+		}
+	}
+}
+
+func TestDaysRemaining(t *testing.T) {
+	for _, test := range []struct {
+		d           Date
+		month, year int
+	}{
+		{Date{2016, 1, 1}, 30, 365},
+		{Date{2016, 1, 31}, 0, 335},
+		{Date{2016, 2, 1}, 28, 334},
+		{Date{2016, 2, 28}, 1, 307},
+		{Date{2016, 2, 29}, 0, 306},
+		{Date{2015, 2, 28}, 0, 306},
+		{Date{2016, 4, 30}, 0, 245},
+		{Date{2016, 12, 30}, 1, 1},
+		{Date{2016, 12, 31}, 0, 0},
+		{Date{2015, 1, 1}, 30, 364},
+	} {
+		if got := test.d.DaysRemainingInMonth(); got != test.month {
+			t.Errorf("%v.DaysRemainingInMonth(): got %d, want %d", test.d, got, test.month)
+		}
+		if got := test.d.DaysRemainingInYear(); got != test.year {
+			t.Errorf("%v.DaysRemainingInYear(): got %d, want %d", test.d, got, test.year)
+		}
+	}
+}
+
 func TestIsFirstOfMonth(t *testing.T) {
 	for _, test := range firstLastCases {
 		t.Run(fmt.Sprintf("%v.IsFirstOfMonth()", test.d), func(t *testing.T) {
@@ -439,6 +1605,11 @@ func TestMarshalJSON(t *testing.T) {
 		want  string
 	}{
 		{Date{1987, 4, 15}, `"1987-04-15"`},
+		{&Date{1987, 4, 15}, `"1987-04-15"`},
+		{[]Date{{1987, 4, 15}, {2016, 1, 2}}, `["1987-04-15","2016-01-02"]`},
+		{map[string]Date{"a": {1987, 4, 15}}, `{"a":"1987-04-15"}`},
+		{map[Date]int{{1987, 4, 15}: 1}, `{"1987-04-15":1}`},
+		{struct{ D Date }{Date{1987, 4, 15}}, `{"D":"1987-04-15"}`},
 	} {
 		bgot, err := json.Marshal(test.value)
 		if err != nil {
@@ -476,3 +1647,1141 @@ func TestUnmarshalJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestWeekday(t *testing.T) {
+	for _, test := range []struct {
+		date Date
+		want time.Weekday
+	}{
+		{Date{1970, 1, 1}, time.Thursday},
+		{Date{1969, 12, 31}, time.Wednesday},
+		{Date{2000, 2, 29}, time.Tuesday},
+		{Date{2014, 7, 29}, time.Tuesday},
+		{Date{2016, 3, 1}, time.Tuesday},
+		{Date{2016, 3, 6}, time.Sunday},
+		{Date{999, 1, 26}, time.Saturday},
+		{Date{101, 1, 1}, time.Saturday},
+		{Date{1, 1, 1}, time.Monday},
+		{Date{0, 1, 1}, time.Saturday},
+		{Date{-1, 12, 31}, time.Friday},
+		{Date{-400, 3, 1}, time.Wednesday},
+	} {
+		if got := test.date.Weekday(); got != test.want {
+			t.Errorf("%#v.Weekday() = %v, want %v", test.date, got, test.want)
+		}
+		if got, want := test.date.Weekday(), test.date.In(time.UTC).Weekday(); got != want {
+			t.Errorf("%#v.Weekday() = %v, but In(time.UTC).Weekday() = %v", test.date, got, want)
+		}
+	}
+}
+
+func TestQuarter(t *testing.T) {
+	for _, test := range []struct {
+		date Date
+		want int
+	}{
+		{Date{2016, 1, 1}, 1},
+		{Date{2016, 2, 29}, 1},
+		{Date{2016, 3, 31}, 1},
+		{Date{2016, 4, 1}, 2},
+		{Date{2016, 6, 30}, 2},
+		{Date{2016, 7, 1}, 3},
+		{Date{2016, 9, 30}, 3},
+		{Date{2016, 10, 1}, 4},
+		{Date{2016, 12, 31}, 4},
+	} {
+		if got := test.date.Quarter(); got != test.want {
+			t.Errorf("%#v.Quarter() = %d, want %d", test.date, got, test.want)
+		}
+	}
+}
+
+func TestIsLeapYear(t *testing.T) {
+	for _, test := range []struct {
+		year int
+		want bool
+	}{
+		{2000, true},
+		{1900, false},
+		{2004, true},
+		{2001, false},
+		{2100, false},
+		{2400, true},
+		{0, true},
+		{-1, false},
+		{-4, true},
+		{-100, false},
+		{-400, true},
+	} {
+		if got := IsLeapYear(test.year); got != test.want {
+			t.Errorf("IsLeapYear(%d) = %t, want %t", test.year, got, test.want)
+		}
+		if got := (Date{test.year, 1, 1}).IsLeapYear(); got != test.want {
+			t.Errorf("Date{%d, 1, 1}.IsLeapYear() = %t, want %t", test.year, got, test.want)
+		}
+	}
+}
+
+func TestDaysInMonth(t *testing.T) {
+	for _, test := range []struct {
+		year  int
+		month time.Month
+		want  int
+	}{
+		{2016, time.January, 31},
+		{2016, time.February, 29},
+		{2017, time.February, 28},
+		{1900, time.February, 28},
+		{2000, time.February, 29},
+		{2016, time.April, 30},
+		{2016, time.December, 31},
+		{-4, time.February, 29},
+		{2016, 0, -1},
+		{2016, 13, -1},
+	} {
+		if got := DaysInMonth(test.year, test.month); got != test.want {
+			t.Errorf("DaysInMonth(%d, %v) = %d, want %d", test.year, test.month, got, test.want)
+		}
+		if test.want == -1 {
+			continue
+		}
+		d := Date{test.year, test.month, 1}
+		if got := d.DaysInMonth(); got != test.want {
+			t.Errorf("%#v.DaysInMonth() = %d, want %d", d, got, test.want)
+		}
+	}
+}
+
+func TestDaysInYear(t *testing.T) {
+	for _, test := range []struct {
+		date Date
+		want int
+	}{
+		{Date{2016, 1, 1}, 366},
+		{Date{2017, 6, 15}, 365},
+		{Date{1900, 12, 31}, 365},
+		{Date{2000, 12, 31}, 366},
+		{Date{0, 1, 1}, 366},
+	} {
+		if got := test.date.DaysInYear(); got != test.want {
+			t.Errorf("%#v.DaysInYear() = %d, want %d", test.date, got, test.want)
+		}
+	}
+}
+
+func TestWeekOfMonth(t *testing.T) {
+	for _, test := range []struct {
+		date      Date
+		weekStart time.Weekday
+		want      int
+	}{
+		{Date{2016, 3, 1}, time.Monday, 1},
+		{Date{2016, 3, 6}, time.Monday, 1},
+		{Date{2016, 3, 7}, time.Monday, 2},
+		{Date{2016, 3, 31}, time.Monday, 5},
+		{Date{2016, 2, 1}, time.Monday, 1},
+		{Date{2016, 2, 29}, time.Monday, 5},
+		{Date{2016, 5, 1}, time.Monday, 1},
+		{Date{2016, 5, 2}, time.Monday, 2},
+		{Date{2016, 5, 31}, time.Monday, 6},
+		{Date{2016, 3, 5}, time.Sunday, 1},
+		{Date{2016, 3, 6}, time.Sunday, 2},
+		{Date{2016, 5, 1}, time.Sunday, 1},
+		{Date{2016, 5, 7}, time.Sunday, 1},
+		{Date{2016, 5, 8}, time.Sunday, 2},
+	} {
+		if got := test.date.WeekOfMonthStarting(test.weekStart); got != test.want {
+			t.Errorf("%#v.WeekOfMonthStarting(%v) = %d, want %d", test.date, test.weekStart, got, test.want)
+		}
+		if test.weekStart == time.Monday {
+			if got := test.date.WeekOfMonth(); got != test.want {
+				t.Errorf("%#v.WeekOfMonth() = %d, want %d", test.date, got, test.want)
+			}
+		}
+	}
+}
+
+func TestIsWeekend(t *testing.T) {
+	for _, test := range []struct {
+		date Date
+		want bool
+	}{
+		{Date{2016, 3, 4}, false}, // friday
+		{Date{2016, 3, 5}, true},  // saturday
+		{Date{2016, 3, 6}, true},  // sunday
+		{Date{2016, 3, 7}, false}, // monday
+		{Date{2016, 3, 9}, false}, // wednesday
+	} {
+		if got := test.date.IsWeekend(); got != test.want {
+			t.Errorf("%#v.IsWeekend() = %t, want %t", test.date, got, test.want)
+		}
+		if got := test.date.IsWeekday(); got != !test.want {
+			t.Errorf("%#v.IsWeekday() = %t, want %t", test.date, got, !test.want)
+		}
+	}
+}
+
+func TestISOWeek(t *testing.T) {
+	for _, test := range []struct {
+		date       Date
+		year, week int
+	}{
+		{Date{2016, 1, 1}, 2015, 53},
+		{Date{2016, 1, 3}, 2015, 53},
+		{Date{2016, 1, 4}, 2016, 1},
+		{Date{2016, 12, 31}, 2016, 52},
+		{Date{2014, 12, 29}, 2015, 1},
+		{Date{2015, 12, 31}, 2015, 53},
+	} {
+		year, week := test.date.ISOWeek()
+		if year != test.year || week != test.week {
+			t.Errorf("%#v.ISOWeek() = %d, %d, want %d, %d", test.date, year, week, test.year, test.week)
+		}
+		if got := test.date.ISOYear(); got != test.year {
+			t.Errorf("%#v.ISOYear() = %d, want %d", test.date, got, test.year)
+		}
+	}
+}
+
+func TestISOWeekDate(t *testing.T) {
+	for _, test := range []struct {
+		d    Date
+		want string
+	}{
+		{Date{2016, 1, 1}, "2015-W53-5"},
+		{Date{2016, 1, 3}, "2015-W53-7"},
+		{Date{2016, 1, 4}, "2016-W01-1"},
+		{Date{2016, 1, 8}, "2016-W01-5"},
+		{Date{2016, 12, 31}, "2016-W52-6"},
+		{Date{2014, 12, 29}, "2015-W01-1"},
+		{Date{2015, 1, 1}, "2015-W01-4"},
+		{Date{2015, 12, 31}, "2015-W53-4"},
+		{Date{2019, 12, 30}, "2020-W01-1"},
+		{Date{2020, 12, 31}, "2020-W53-4"},
+		{Date{2021, 1, 3}, "2020-W53-7"},
+	} {
+		if got := test.d.FormatISOWeek(); got != test.want {
+			t.Errorf("%v.FormatISOWeek() = %q, want %q", test.d, got, test.want)
+		}
+		got, err := ParseISOWeekDate(test.want)
+		if assert.NoError(t, err) && got != test.d {
+			t.Errorf("ParseISOWeekDate(%q) = %v, want %v", test.want, got, test.d)
+		}
+	}
+
+	for _, s := range []string{
+		"",
+		"2016-01-5",
+		"2016-W1-5",
+		"2016W01-5",
+		"2016-W01-05",
+		"2016-w01-5",
+		"2016-W01-0",
+		"2016-W01-8",
+		"2016-W00-1",
+		"2016-W53-1",
+		"2015-W54-1",
+	} {
+		if got, err := ParseISOWeekDate(s); err == nil {
+			t.Errorf("ParseISOWeekDate(%q) = %v, want error", s, got)
+		}
+	}
+
+	_, err := ParseISOWeekDate("2016-01-02")
+	assert.True(t, errors.Is(err, ErrInvalidFormat))
+}
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	for _, test := range []struct {
+		year    int
+		month   time.Month
+		weekday time.Weekday
+		n       int
+		want    Date // if empty, expect an error
+	}{
+		{2016, time.January, time.Monday, 3, Date{2016, 1, 18}},
+		{2016, time.January, time.Friday, 1, Date{2016, 1, 1}},
+		{2016, time.January, time.Friday, 5, Date{2016, 1, 29}},
+		{2016, time.January, time.Sunday, 5, Date{2016, 1, 31}},
+		{2016, time.January, time.Monday, 5, Date{}},
+		{2016, time.February, time.Monday, 5, Date{2016, 2, 29}},
+		{2015, time.February, time.Monday, 5, Date{}},
+		{2016, time.May, time.Monday, -1, Date{2016, 5, 30}},
+		{2016, time.May, time.Tuesday, -1, Date{2016, 5, 31}},
+		{2016, time.May, time.Tuesday, -2, Date{2016, 5, 24}},
+		{2016, time.May, time.Tuesday, -5, Date{2016, 5, 3}},
+		{2016, time.May, time.Tuesday, -6, Date{}},
+		{2016, time.November, time.Thursday, 4, Date{2016, 11, 24}},
+		{2016, time.November, time.Thursday, 0, Date{}},
+		{2016, 13, time.Thursday, 1, Date{}},
+	} {
+		got, err := NthWeekdayOfMonth(test.year, test.month, test.weekday, test.n)
+		if got != test.want {
+			t.Errorf("NthWeekdayOfMonth(%d, %v, %v, %d) = %+v, want %+v", test.year, test.month, test.weekday, test.n, got, test.want)
+		}
+		if (err != nil) != (test.want == Date{}) {
+			t.Errorf("NthWeekdayOfMonth(%d, %v, %v, %d): unexpected error state %v", test.year, test.month, test.weekday, test.n, err)
+		}
+	}
+}
+
+func TestLastWeekdayOfMonth(t *testing.T) {
+	for _, test := range []struct {
+		date    Date
+		weekday time.Weekday
+		want    Date
+	}{
+		{Date{2016, 5, 10}, time.Monday, Date{2016, 5, 30}},
+		{Date{2016, 5, 31}, time.Tuesday, Date{2016, 5, 31}},
+		{Date{2016, 5, 1}, time.Wednesday, Date{2016, 5, 25}},
+		{Date{2016, 2, 1}, time.Monday, Date{2016, 2, 29}},
+		{Date{2015, 2, 1}, time.Monday, Date{2015, 2, 23}},
+		{Date{2015, 2, 1}, time.Saturday, Date{2015, 2, 28}},
+		{Date{2016, 4, 15}, time.Saturday, Date{2016, 4, 30}},
+		{Date{2016, 4, 15}, time.Friday, Date{2016, 4, 29}},
+	} {
+		if got := test.date.LastWeekdayOfMonth(test.weekday); got != test.want {
+			t.Errorf("%#v.LastWeekdayOfMonth(%v) = %#v, want %#v", test.date, test.weekday, got, test.want)
+		}
+	}
+}
+
+func TestNextPreviousWeekday(t *testing.T) {
+	for _, test := range []struct {
+		date                     Date
+		weekday                  time.Weekday
+		next, nextOrSame         Date
+		previous, previousOrSame Date
+	}{
+		{
+			date:           Date{2016, 3, 2}, // wednesday
+			weekday:        time.Monday,
+			next:           Date{2016, 3, 7},
+			nextOrSame:     Date{2016, 3, 7},
+			previous:       Date{2016, 2, 29},
+			previousOrSame: Date{2016, 2, 29},
+		},
+		{
+			date:           Date{2016, 3, 7}, // monday
+			weekday:        time.Monday,
+			next:           Date{2016, 3, 14},
+			nextOrSame:     Date{2016, 3, 7},
+			previous:       Date{2016, 2, 29},
+			previousOrSame: Date{2016, 3, 7},
+		},
+		{
+			date:           Date{2016, 12, 30}, // friday
+			weekday:        time.Sunday,
+			next:           Date{2017, 1, 1},
+			nextOrSame:     Date{2017, 1, 1},
+			previous:       Date{2016, 12, 25},
+			previousOrSame: Date{2016, 12, 25},
+		},
+	} {
+		if got := test.date.NextWeekday(test.weekday); got != test.next {
+			t.Errorf("%#v.NextWeekday(%v) = %#v, want %#v", test.date, test.weekday, got, test.next)
+		}
+		if got := test.date.NextOrSameWeekday(test.weekday); got != test.nextOrSame {
+			t.Errorf("%#v.NextOrSameWeekday(%v) = %#v, want %#v", test.date, test.weekday, got, test.nextOrSame)
+		}
+		if got := test.date.PreviousWeekday(test.weekday); got != test.previous {
+			t.Errorf("%#v.PreviousWeekday(%v) = %#v, want %#v", test.date, test.weekday, got, test.previous)
+		}
+		if got := test.date.PreviousOrSameWeekday(test.weekday); got != test.previousOrSame {
+			t.Errorf("%#v.PreviousOrSameWeekday(%v) = %#v, want %#v", test.date, test.weekday, got, test.previousOrSame)
+		}
+	}
+}
+
+func TestOrdinalDay(t *testing.T) {
+	for day, want := range map[int]string{
+		1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 10: "10th",
+		11: "11th", 12: "12th", 13: "13th", 14: "14th",
+		21: "21st", 22: "22nd", 23: "23rd", 24: "24th",
+		30: "30th", 31: "31st",
+	} {
+		d := Date{2016, 1, day}
+		if got := d.OrdinalDay(); got != want {
+			t.Errorf("%#v.OrdinalDay() = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestCenturyDecade(t *testing.T) {
+	for _, test := range []struct {
+		year    int
+		century int
+		decade  int
+	}{
+		{1987, 20, 1980},
+		{1901, 20, 1900},
+		{2000, 20, 2000},
+		{2001, 21, 2000},
+		{1, 1, 0},
+		{100, 1, 100},
+		{0, -1, 0},
+		{-5, -1, -10},
+		{-99, -1, -100},
+		{-100, -2, -100},
+	} {
+		d := Date{test.year, 1, 1}
+		if got := d.Century(); got != test.century {
+			t.Errorf("%#v.Century() = %d, want %d", d, got, test.century)
+		}
+		if got := d.Decade(); got != test.decade {
+			t.Errorf("%#v.Decade() = %d, want %d", d, got, test.decade)
+		}
+	}
+}
+
+func TestAddMonthsOverflow(t *testing.T) {
+	for _, test := range []struct {
+		desc  string
+		start Date
+		end   Date
+		n     int
+	}{
+		{
+			desc:  "zero months noop",
+			start: Date{2014, 5, 9},
+			end:   Date{2014, 5, 9},
+			n:     0,
+		},
+		{
+			desc:  "no overflow",
+			start: Date{2014, 12, 15},
+			end:   Date{2015, 1, 15},
+			n:     1,
+		},
+		{
+			desc:  "february overflows into march",
+			start: Date{2014, 1, 31},
+			end:   Date{2014, 3, 3},
+			n:     1,
+		},
+		{
+			desc:  "february overflows into march (leap year)",
+			start: Date{2012, 1, 31},
+			end:   Date{2012, 3, 2},
+			n:     1,
+		},
+		{
+			desc:  "leap day overflows in a non-leap year",
+			start: Date{2012, 2, 29},
+			end:   Date{2013, 3, 1},
+			n:     12,
+		},
+		{
+			desc:  "negative months overflow",
+			start: Date{2014, 3, 31},
+			end:   Date{2014, 3, 3},
+			n:     -1,
+		},
+		{
+			desc:  "thirty day month",
+			start: Date{2014, 5, 31},
+			end:   Date{2014, 7, 1},
+			n:     1,
+		},
+	} {
+		if got := test.start.AddMonthsOverflow(test.n); got != test.end {
+			t.Errorf("[%s] %#v.AddMonthsOverflow(%v) = %#v, want %#v", test.desc, test.start, test.n, got, test.end)
+		}
+		if got, want := test.start.AddMonthsOverflow(test.n), DateOf(test.start.In(time.UTC).AddDate(0, test.n, 0)); got != want {
+			t.Errorf("[%s] %#v.AddMonthsOverflow(%v) = %#v, but time.AddDate gives %#v", test.desc, test.start, test.n, got, want)
+		}
+	}
+}
+
+func TestAddYears(t *testing.T) {
+	for _, test := range []struct {
+		desc  string
+		start Date
+		end   Date
+		n     int
+	}{
+		{
+			desc:  "zero years noop",
+			start: Date{2014, 5, 9},
+			end:   Date{2014, 5, 9},
+			n:     0,
+		},
+		{
+			desc:  "positive years",
+			start: Date{2014, 5, 9},
+			end:   Date{2016, 5, 9},
+			n:     2,
+		},
+		{
+			desc:  "negative years",
+			start: Date{2014, 5, 9},
+			end:   Date{2004, 5, 9},
+			n:     -10,
+		},
+		{
+			desc:  "leap day to non-leap year",
+			start: Date{2012, 2, 29},
+			end:   Date{2013, 2, 28},
+			n:     1,
+		},
+		{
+			desc:  "leap day to leap year",
+			start: Date{2012, 2, 29},
+			end:   Date{2016, 2, 29},
+			n:     4,
+		},
+		{
+			desc:  "leap day backwards to non-leap century",
+			start: Date{2000, 2, 29},
+			end:   Date{1900, 2, 28},
+			n:     -100,
+		},
+	} {
+		if got := test.start.AddYears(test.n); got != test.end {
+			t.Errorf("[%s] %#v.AddYears(%v) = %#v, want %#v", test.desc, test.start, test.n, got, test.end)
+		}
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	for _, test := range []struct {
+		desc  string
+		start Date
+		end   Date
+		n     int
+	}{
+		{
+			desc:  "zero days noop",
+			start: Date{2016, 3, 5},
+			end:   Date{2016, 3, 5},
+			n:     0,
+		},
+		{
+			desc:  "within a week",
+			start: Date{2016, 3, 7},
+			end:   Date{2016, 3, 10},
+			n:     3,
+		},
+		{
+			desc:  "friday to monday",
+			start: Date{2016, 3, 4},
+			end:   Date{2016, 3, 7},
+			n:     1,
+		},
+		{
+			desc:  "monday to friday backwards",
+			start: Date{2016, 3, 7},
+			end:   Date{2016, 3, 4},
+			n:     -1,
+		},
+		{
+			desc:  "saturday to monday",
+			start: Date{2016, 3, 5},
+			end:   Date{2016, 3, 7},
+			n:     1,
+		},
+		{
+			desc:  "sunday to monday",
+			start: Date{2016, 3, 6},
+			end:   Date{2016, 3, 7},
+			n:     1,
+		},
+		{
+			desc:  "saturday plus a week of business days",
+			start: Date{2016, 3, 5},
+			end:   Date{2016, 3, 11},
+			n:     5,
+		},
+		{
+			desc:  "sunday backwards to friday",
+			start: Date{2016, 3, 6},
+			end:   Date{2016, 3, 4},
+			n:     -1,
+		},
+		{
+			desc:  "crossing a month boundary",
+			start: Date{2016, 2, 26},
+			end:   Date{2016, 3, 2},
+			n:     3,
+		},
+		{
+			desc:  "crossing a year boundary",
+			start: Date{2015, 12, 30},
+			end:   Date{2016, 1, 5},
+			n:     4,
+		},
+		{
+			desc:  "crossing a year boundary backwards",
+			start: Date{2016, 1, 5},
+			end:   Date{2015, 12, 30},
+			n:     -4,
+		},
+		{
+			desc:  "large n",
+			start: Date{2016, 1, 4},
+			end:   Date{2019, 11, 4},
+			n:     1000,
+		},
+		{
+			desc:  "large negative n",
+			start: Date{2019, 11, 4},
+			end:   Date{2016, 1, 4},
+			n:     -1000,
+		},
+	} {
+		if got := test.start.AddBusinessDays(test.n); got != test.end {
+			t.Errorf("[%s] %#v.AddBusinessDays(%v) = %#v, want %#v", test.desc, test.start, test.n, got, test.end)
+		}
+	}
+}
+
+func TestBusinessDaysBetween(t *testing.T) {
+	for _, test := range []struct {
+		desc       string
+		start, end Date
+		holidays   []Date
+		want       int
+	}{
+		{
+			desc:  "same day",
+			start: Date{2016, 3, 7},
+			end:   Date{2016, 3, 7},
+			want:  0,
+		},
+		{
+			desc:  "monday to friday",
+			start: Date{2016, 3, 7},
+			end:   Date{2016, 3, 11},
+			want:  4,
+		},
+		{
+			desc:  "monday to monday",
+			start: Date{2016, 3, 7},
+			end:   Date{2016, 3, 14},
+			want:  5,
+		},
+		{
+			desc:  "saturday to monday",
+			start: Date{2016, 3, 5},
+			end:   Date{2016, 3, 7},
+			want:  0,
+		},
+		{
+			desc:  "friday to tuesday",
+			start: Date{2016, 3, 4},
+			end:   Date{2016, 3, 8},
+			want:  2,
+		},
+		{
+			desc:  "crossing a year boundary",
+			start: Date{2015, 12, 28},
+			end:   Date{2016, 1, 11},
+			want:  10,
+		},
+		{
+			desc:  "whole leap year",
+			start: Date{2016, 1, 1},
+			end:   Date{2017, 1, 1},
+			want:  261,
+		},
+		{
+			desc:  "reversed",
+			start: Date{2016, 3, 14},
+			end:   Date{2016, 3, 7},
+			want:  -5,
+		},
+		{
+			desc:     "holidays",
+			start:    Date{2015, 12, 21},
+			end:      Date{2016, 1, 4},
+			holidays: []Date{{2015, 12, 25}, {2015, 12, 26}, {2016, 1, 1}, {2016, 1, 1}, {2016, 1, 4}},
+			want:     8,
+		},
+		{
+			desc:     "holidays reversed",
+			start:    Date{2016, 1, 4},
+			end:      Date{2015, 12, 21},
+			holidays: []Date{{2015, 12, 25}, {2016, 1, 1}},
+			want:     -8,
+		},
+	} {
+		if got := BusinessDaysBetween(test.start, test.end, test.holidays...); got != test.want {
+			t.Errorf("[%s] BusinessDaysBetween(%v, %v) = %d, want %d", test.desc, test.start, test.end, got, test.want)
+		}
+	}
+}
+
+func TestCountWeekday(t *testing.T) {
+	for _, test := range []struct {
+		start, end Date
+		w          time.Weekday
+		want       int
+	}{
+		{Date{2016, 3, 1}, Date{2016, 3, 1}, time.Tuesday, 1},
+		{Date{2016, 3, 1}, Date{2016, 3, 1}, time.Wednesday, 0},
+		{Date{2016, 3, 1}, Date{2016, 3, 7}, time.Tuesday, 1},
+		{Date{2016, 3, 1}, Date{2016, 3, 8}, time.Tuesday, 2},
+		{Date{2016, 3, 2}, Date{2016, 3, 8}, time.Tuesday, 1},
+		{Date{2016, 3, 2}, Date{2016, 3, 7}, time.Tuesday, 0},
+		{Date{2016, 3, 1}, Date{2016, 3, 31}, time.Tuesday, 5},
+		{Date{2016, 3, 1}, Date{2016, 3, 31}, time.Friday, 4},
+		{Date{2016, 1, 1}, Date{2016, 12, 31}, time.Friday, 53},
+		{Date{2016, 1, 1}, Date{2016, 12, 31}, time.Sunday, 52},
+		{Date{2016, 3, 8}, Date{2016, 3, 1}, time.Tuesday, 0},
+	} {
+		got := CountWeekday(test.start, test.end, test.w)
+		if got != test.want {
+			t.Errorf("CountWeekday(%v, %v, %v) = %d, want %d", test.start, test.end, test.w, got, test.want)
+		}
+
+		n := 0
+		for d := test.start; d.BeforeOrOn(test.end); d = d.AddDays(1) {
+			if d.Weekday() == test.w {
+				n++
+			}
+		}
+		if got != n {
+			t.Errorf("CountWeekday(%v, %v, %v) = %d, but counting found %d", test.start, test.end, test.w, got, n)
+		}
+	}
+}
+
+func TestWeekdaysInMonth(t *testing.T) {
+	for _, test := range []struct {
+		d                 Date
+		weekdays, weekend int
+	}{
+		{Date{2015, 2, 10}, 20, 8},
+		{Date{2016, 2, 10}, 21, 8},
+		{Date{2020, 2, 10}, 20, 9},
+		{Date{2016, 1, 10}, 21, 10},
+		{Date{2016, 3, 10}, 23, 8},
+		{Date{2016, 4, 10}, 21, 9},
+		{Date{2016, 7, 10}, 21, 10},
+	} {
+		if got := test.d.WeekdaysInMonth(); got != test.weekdays {
+			t.Errorf("%v.WeekdaysInMonth(): got %d, want %d", test.d, got, test.weekdays)
+		}
+		if got := test.d.WeekendDaysInMonth(); got != test.weekend {
+			t.Errorf("%v.WeekendDaysInMonth(): got %d, want %d", test.d, got, test.weekend)
+		}
+		if got := test.d.WeekdaysInMonth() + test.d.WeekendDaysInMonth(); got != test.d.DaysInMonth() {
+			t.Errorf("%v: WeekdaysInMonth() + WeekendDaysInMonth() = %d, want %d", test.d, got, test.d.DaysInMonth())
+		}
+	}
+}
+
+func TestNextPreviousBusinessDay(t *testing.T) {
+	for _, test := range []struct {
+		desc           string
+		date           Date
+		holidays       []Date
+		next, previous Date
+	}{
+		{
+			desc:     "midweek",
+			date:     Date{2016, 3, 9},
+			next:     Date{2016, 3, 10},
+			previous: Date{2016, 3, 8},
+		},
+		{
+			desc:     "friday",
+			date:     Date{2016, 3, 4},
+			next:     Date{2016, 3, 7},
+			previous: Date{2016, 3, 3},
+		},
+		{
+			desc:     "monday",
+			date:     Date{2016, 3, 7},
+			next:     Date{2016, 3, 8},
+			previous: Date{2016, 3, 4},
+		},
+		{
+			desc:     "saturday",
+			date:     Date{2016, 3, 5},
+			next:     Date{2016, 3, 7},
+			previous: Date{2016, 3, 4},
+		},
+		{
+			desc:     "holidays either side of a weekend",
+			date:     Date{2016, 3, 26},
+			holidays: []Date{{2016, 3, 25}, {2016, 3, 28}},
+			next:     Date{2016, 3, 29},
+			previous: Date{2016, 3, 24},
+		},
+	} {
+		if got := test.date.NextBusinessDay(test.holidays...); got != test.next {
+			t.Errorf("[%s] %#v.NextBusinessDay() = %#v, want %#v", test.desc, test.date, got, test.next)
+		}
+		if got := test.date.PreviousBusinessDay(test.holidays...); got != test.previous {
+			t.Errorf("[%s] %#v.PreviousBusinessDay() = %#v, want %#v", test.desc, test.date, got, test.previous)
+		}
+	}
+}
+
+func TestYearDay(t *testing.T) {
+	for _, test := range []struct {
+		date Date
+		want int
+	}{
+		{Date{2016, 1, 1}, 1},
+		{Date{2016, 2, 29}, 60},
+		{Date{2016, 3, 1}, 61},
+		{Date{2015, 3, 1}, 60},
+		{Date{2016, 12, 31}, 366},
+		{Date{2015, 12, 31}, 365},
+		{Date{-1, 12, 31}, 365},
+	} {
+		if got := test.date.YearDay(); got != test.want {
+			t.Errorf("%#v.YearDay() = %d, want %d", test.date, got, test.want)
+		}
+		if got, want := test.date.YearDay(), test.date.In(time.UTC).YearDay(); got != want {
+			t.Errorf("%#v.YearDay() = %d, but In(time.UTC).YearDay() = %d", test.date, got, want)
+		}
+	}
+}
+
+func TestOrdinalDate(t *testing.T) {
+	for _, test := range []struct {
+		d    Date
+		want string
+	}{
+		{Date{2016, 1, 1}, "2016-001"},
+		{Date{2016, 2, 29}, "2016-060"},
+		{Date{2016, 12, 31}, "2016-366"},
+		{Date{2015, 12, 31}, "2015-365"},
+		{Date{2000, 12, 31}, "2000-366"},
+		{Date{3, 4, 5}, "0003-095"},
+	} {
+		if got := test.d.FormatOrdinal(); got != test.want {
+			t.Errorf("%v.FormatOrdinal() = %q, want %q", test.d, got, test.want)
+		}
+		got, err := ParseOrdinalDate(test.want)
+		if assert.NoError(t, err) && got != test.d {
+			t.Errorf("ParseOrdinalDate(%q) = %v, want %v", test.want, got, test.d)
+		}
+	}
+
+	for _, test := range []struct {
+		s    string
+		want error
+	}{
+		{"", ErrInvalidFormat},
+		{"2016-1", ErrInvalidFormat},
+		{"2016-0001", ErrInvalidFormat},
+		{"2016001", ErrInvalidFormat},
+		{"2016-01-01", ErrInvalidFormat},
+		{"2016-00a", ErrInvalidFormat},
+		{"2016-000", ErrDayOutOfRange},
+		{"2016-367", ErrDayOutOfRange},
+		{"2015-366", ErrDayOutOfRange},
+		{"1900-366", ErrDayOutOfRange},
+	} {
+		if got, err := ParseOrdinalDate(test.s); !errors.Is(err, test.want) {
+			t.Errorf("ParseOrdinalDate(%q) = %v, %v, want error %v", test.s, got, err, test.want)
+		}
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	dates := []Date{
+		{-2147483648, 1, 1},
+		{-12345, 6, 7},
+		{-1, 12, 31},
+		{0, 1, 1},
+		{1, 1, 1},
+		{999, 1, 26},
+		{1987, 4, 15},
+		{1987, 4, 16},
+		{1987, 5, 1},
+		{2016, 2, 29},
+		{2147483647, 12, 31},
+	}
+
+	var prev []byte
+	for _, d := range append([]Date{{}}, dates...) {
+		b, err := d.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%#v.MarshalBinary(): %v", d, err)
+		}
+
+		var got Date
+		if err := got.UnmarshalBinary(b); err != nil {
+			t.Fatalf("UnmarshalBinary(%x): %v", b, err)
+		}
+		if got != d {
+			t.Errorf("UnmarshalBinary(%x) = %#v, want %#v", b, got, d)
+		}
+
+		if d != (Date{}) && prev != nil && string(prev) >= string(b) {
+			t.Errorf("%#v encoded as %x, which doesn't sort after %x", d, b, prev)
+		}
+		if d != (Date{}) {
+			prev = b
+		}
+	}
+
+	b, _ := Date{1987, 4, 15}.MarshalBinary()
+	assert.Equal(t, []byte{0x80, 0x00, 0x07, 0xc3, 0x04, 0x0f}, b)
+
+	for _, d := range []Date{{2016, 256, 1}, {2016, 1, -1}} {
+		if _, err := d.MarshalBinary(); err == nil {
+			t.Errorf("%#v.MarshalBinary(): got nil, want error", d)
+		}
+	}
+
+	var d Date
+	for _, bad := range [][]byte{nil, {0x80, 0x00, 0x07, 0xc3, 0x04}, {0x80, 0x00, 0x07, 0xc3, 0x04, 0x0f, 0x00}} {
+		if err := d.UnmarshalBinary(bad); err == nil {
+			t.Errorf("UnmarshalBinary(%x): got nil, want error", bad)
+		}
+	}
+}
+
+func TestGob(t *testing.T) {
+	type record struct {
+		Name  string
+		Date  Date
+		Dates []Date
+	}
+
+	want := record{
+		Name:  "test",
+		Date:  Date{1987, 4, 15},
+		Dates: []Date{{-1, 12, 31}, {}, {2016, 2, 29}},
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatal(err)
+	}
+
+	var got record
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, want, got)
+
+	var d Date
+	for _, bad := range [][]byte{nil, {2, 0x80, 0x00, 0x07, 0xc3, 0x04, 0x0f}, {1, 0x80}} {
+		if err := d.GobDecode(bad); err == nil {
+			t.Errorf("GobDecode(%x): got nil, want error", bad)
+		}
+	}
+}
+
+func TestXML(t *testing.T) {
+	type record struct {
+		XMLName xml.Name `xml:"record"`
+		Attr    Date     `xml:"at,attr"`
+		Elem    Date     `xml:"on"`
+		Ptr     *Date    `xml:"maybe,omitempty"`
+	}
+
+	want := record{
+		XMLName: xml.Name{Local: "record"},
+		Attr:    Date{1987, 4, 15},
+		Elem:    Date{2016, 2, 29},
+	}
+	wantXML := `<record at="1987-04-15"><on>2016-02-29</on></record>`
+
+	b, err := xml.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(b); got != wantXML {
+		t.Errorf("xml.Marshal(%#v) = %s, want %s", want, got, wantXML)
+	}
+
+	var got record
+	if err := xml.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, want, got)
+
+	for _, bad := range []string{
+		`<record at="bad"><on>2016-02-29</on></record>`,
+		`<record at="1987-04-15"><on>2016-02-30</on></record>`,
+		`<record at="1987-04-15"><on><x/></on></record>`,
+	} {
+		var r record
+		if err := xml.Unmarshal([]byte(bad), &r); err == nil {
+			t.Errorf("xml.Unmarshal(%s): got nil, want error", bad)
+		}
+	}
+}
+
+func TestYAML(t *testing.T) {
+	testYAML(t, yaml.Marshal, yaml.Unmarshal)
+}
+
+func TestYAMLv3(t *testing.T) {
+	testYAML(t, yamlv3.Marshal, yamlv3.Unmarshal)
+}
+
+func testYAML(t *testing.T, marshal func(interface{}) ([]byte, error), unmarshal func([]byte, interface{}) error) {
+	type record struct {
+		A Date `yaml:"a"`
+		B Date `yaml:"b"`
+	}
+
+	want := record{A: Date{1987, 4, 15}, B: Date{2016, 2, 29}}
+
+	b, err := marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, wantYAML := string(b), "a: \"1987-04-15\"\nb: \"2016-02-29\"\n"; got != wantYAML {
+		t.Errorf("yaml.Marshal(%#v) = %q, want %q", want, got, wantYAML)
+	}
+
+	for _, in := range []string{
+		"a: 1987-04-15\nb: 2016-02-29\n",
+		"a: \"1987-04-15\"\nb: '2016-02-29'\n",
+	} {
+		var got record
+		if err := unmarshal([]byte(in), &got); err != nil {
+			t.Fatalf("yaml.Unmarshal(%q): %v", in, err)
+		}
+		assert.Equal(t, want, got)
+	}
+
+	for _, bad := range []string{
+		"a: bad\n",
+		"a: 2016-02-30\n",
+		"a: 19870415\n",
+		"a: [1987, 4, 15]\n",
+		"a: {year: 1987}\n",
+	} {
+		var r record
+		if err := unmarshal([]byte(bad), &r); err == nil {
+			t.Errorf("yaml.Unmarshal(%q): got nil, want error", bad)
+		}
+	}
+
+	var r record
+	if err := unmarshal([]byte("a: '2016-02-30'\n"), &r); !errors.Is(err, ErrDayOutOfRange) {
+		t.Errorf("yaml.Unmarshal(%q): got %v, want %v", "a: '2016-02-30'", err, ErrDayOutOfRange)
+	}
+}
+
+func TestScan(t *testing.T) {
+	for _, test := range []struct {
+		src  interface{}
+		want Date // if empty, expect an error
+	}{
+		{time.Date(1987, 4, 15, 23, 59, 59, 0, time.UTC), Date{1987, 4, 15}},
+		{"1987-04-15", Date{1987, 4, 15}},
+		{"1987-04-15T00:00:00Z", Date{1987, 4, 15}},
+		{[]byte("1987-04-15"), Date{1987, 4, 15}},
+		{int64(0), Date{1970, 1, 1}},
+		{int64(6313), Date{1987, 4, 15}},
+		{int64(-1), Date{1969, 12, 31}},
+		{"infinity", MaxDate},
+		{"-infinity", MinDate},
+		{[]byte("infinity"), MaxDate},
+		{[]byte("-infinity"), MinDate},
+		{"Infinity", Date{}},
+		{"bad", Date{}},
+		{[]byte("bad"), Date{}},
+		{[]byte(nil), Date{}},
+		{float64(1), Date{}},
+		{true, Date{}},
+	} {
+		var got Date
+		err := got.Scan(test.src)
+		if got != test.want {
+			t.Errorf("Scan(%#v) = %+v, want %+v", test.src, got, test.want)
+		}
+		if (err != nil) != (test.want == Date{}) {
+			t.Errorf("Scan(%#v): unexpected error state %v", test.src, err)
+		}
+	}
+
+	d := Date{1987, 4, 15}
+	if err := d.Scan(nil); err != nil {
+		t.Errorf("Scan(nil): unexpected error %v", err)
+	}
+	if d != (Date{}) {
+		t.Errorf("Scan(nil) = %+v, want the zero Date", d)
+	}
+}
+
+func TestValue(t *testing.T) {
+	for _, test := range []struct {
+		d    Date
+		want driver.Value
+	}{
+		{Date{1987, 4, 15}, "1987-04-15"},
+		{Date{3, 2, 4}, "0003-02-04"},
+		{MaxDate, "infinity"},
+		{MinDate, "-infinity"},
+	} {
+		got, err := test.d.Value()
+		if assert.NoError(t, err) && got != test.want {
+			t.Errorf("%#v.Value() = %#v, want %#v", test.d, got, test.want)
+		}
+	}
+}
+
+func TestInfinity(t *testing.T) {
+	assert.True(t, MaxDate.IsValid())
+	assert.True(t, MinDate.IsValid())
+	assert.True(t, MaxDate.IsInfinity())
+	assert.False(t, MaxDate.IsNegativeInfinity())
+	assert.True(t, MinDate.IsNegativeInfinity())
+	assert.False(t, MinDate.IsInfinity())
+	assert.False(t, Date{2016, 1, 1}.IsInfinity())
+	assert.False(t, Date{2016, 1, 1}.IsNegativeInfinity())
+
+	for _, d := range []Date{{2016, 1, 1}, {-4713, 11, 24}, {294276, 12, 31}} {
+		assert.True(t, d.Before(MaxDate), "%v is before MaxDate", d)
+		assert.True(t, d.After(MinDate), "%v is after MinDate", d)
+	}
+
+	for _, d := range []Date{MaxDate, MinDate} {
+		data, err := d.MarshalBinary()
+		if assert.NoError(t, err) {
+			var got Date
+			if assert.NoError(t, got.UnmarshalBinary(data)) {
+				assert.Equal(t, d, got)
+			}
+		}
+
+		v, err := d.Value()
+		if assert.NoError(t, err) {
+			var got Date
+			if assert.NoError(t, got.Scan(v)) {
+				assert.Equal(t, d, got)
+			}
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	d := Date{2016, 1, 2}
+	if assert.NoError(t, d.Set("2016-03-04")) {
+		assert.Equal(t, Date{2016, 3, 4}, d)
+	}
+
+	assert.Error(t, d.Set("2016-03-32"))
+	assert.Equal(t, Date{2016, 3, 4}, d)
+
+	assert.Equal(t, "date", d.Type())
+}
+
+func ExampleDate_Set() {
+	var since, until Date
+
+	fs := flag.NewFlagSet("report", flag.ContinueOnError)
+	fs.Var(&since, "since", "start date")
+	fs.Var(&until, "until", "end date")
+
+	if err := fs.Parse([]string{"--since", "2016-01-01", "--until=2016-01-31"}); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(since, until, until.DaysSince(since))
+	// Output: 2016-01-01 2016-01-31 30
+}
@@ -0,0 +1,90 @@
+package civil
+
+const unixEpochJDN = 2440588
+
+// JulianDayNumber returns the Julian Day Number of d: the number of days
+// since noon on 1 January 4713 BC in the proleptic Julian calendar. The
+// number identifies the day that begins at noon UTC on d, so 2000-01-01 is
+// 2451545.
+func (d Date) JulianDayNumber() int {
+	return d.unixDays() + unixEpochJDN
+}
+
+// FromJulianDayNumber is the inverse of JulianDayNumber.
+func FromJulianDayNumber(jdn int) Date {
+	return dateOfUnixDays(jdn - unixEpochJDN)
+}
+
+// ModifiedJulianDay returns the Modified Julian Day of d, which counts days
+// from midnight on 1858-11-17.
+func (d Date) ModifiedJulianDay() int {
+	return d.JulianDayNumber() - 2400001
+}
+
+// FromModifiedJulianDay is the inverse of ModifiedJulianDay.
+func FromModifiedJulianDay(mjd int) Date {
+	return FromJulianDayNumber(mjd + 2400001)
+}
+
+// ExcelSerial returns d as a serial number in Excel's 1900 date system,
+// where 1900-01-01 is serial 1. Excel treats 1900 as a leap year, so serials
+// from 1900-03-01 onwards are one higher than the number of days since
+// 1899-12-31 and serial 60 is the nonexistent 1900-02-29. That date can
+// still be represented as Date{1900, 2, 29}, which gives 60 here even though
+// it isn't valid.
+func (d Date) ExcelSerial() int {
+	if d == (Date{Year: 1900, Month: 2, Day: 29}) {
+		return 60
+	}
+
+	n := d.DaysSince(Date{Year: 1899, Month: 12, Day: 31})
+	if n >= 60 {
+		n++
+	}
+
+	return n
+}
+
+// FromExcelSerial is the inverse of ExcelSerial. Serial 60 gives the invalid
+// Date{1900, 2, 29}, matching what Excel displays.
+func FromExcelSerial(n int) Date {
+	switch {
+	case n == 60:
+		return Date{Year: 1900, Month: 2, Day: 29}
+	case n > 60:
+		n--
+	}
+
+	return Date{Year: 1899, Month: 12, Day: 31}.AddDays(n)
+}
+
+// DateOfUnix returns the UTC date of the instant sec seconds after the unix
+// epoch.
+func DateOfUnix(sec int64) Date {
+	days := sec / 86400
+	if sec%86400 < 0 {
+		days--
+	}
+	return dateOfUnixDays(int(days))
+}
+
+// DateOfUnixMilli returns the UTC date of the instant msec milliseconds
+// after the unix epoch.
+func DateOfUnixMilli(msec int64) Date {
+	sec := msec / 1000
+	if msec%1000 < 0 {
+		sec--
+	}
+	return DateOfUnix(sec)
+}
+
+// Unix returns the unix time of midnight UTC at the start of d.
+func (d Date) Unix() int64 {
+	return int64(d.unixDays()) * 86400
+}
+
+// UnixMilli returns the unix time in milliseconds of midnight UTC at the
+// start of d.
+func (d Date) UnixMilli() int64 {
+	return d.Unix() * 1000
+}
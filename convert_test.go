@@ -0,0 +1,109 @@
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJulianDayNumber(t *testing.T) {
+	for _, test := range []struct {
+		date     Date
+		jdn, mjd int
+	}{
+		{Date{2000, 1, 1}, 2451545, 51544},
+		{Date{1970, 1, 1}, 2440588, 40587},
+		{Date{1858, 11, 17}, 2400001, 0},
+		{Date{1582, 10, 15}, 2299161, -100840},
+		{Date{1, 1, 1}, 1721426, -678575},
+		{Date{-4713, 11, 24}, 0, -2400001},
+		{Date{2016, 2, 29}, 2457448, 57447},
+	} {
+		if got := test.date.JulianDayNumber(); got != test.jdn {
+			t.Errorf("%v.JulianDayNumber() = %d, want %d", test.date, got, test.jdn)
+		}
+		if got := FromJulianDayNumber(test.jdn); got != test.date {
+			t.Errorf("FromJulianDayNumber(%d) = %v, want %v", test.jdn, got, test.date)
+		}
+		if got := test.date.ModifiedJulianDay(); got != test.mjd {
+			t.Errorf("%v.ModifiedJulianDay() = %d, want %d", test.date, got, test.mjd)
+		}
+		if got := FromModifiedJulianDay(test.mjd); got != test.date {
+			t.Errorf("FromModifiedJulianDay(%d) = %v, want %v", test.mjd, got, test.date)
+		}
+	}
+}
+
+func TestExcelSerial(t *testing.T) {
+	for _, test := range []struct {
+		date   Date
+		serial int
+	}{
+		{Date{1899, 12, 31}, 0},
+		{Date{1900, 1, 1}, 1},
+		{Date{1900, 2, 28}, 59},
+		{Date{1900, 2, 29}, 60},
+		{Date{1900, 3, 1}, 61},
+		{Date{1970, 1, 1}, 25569},
+		{Date{2000, 1, 1}, 36526},
+		{Date{2016, 2, 29}, 42429},
+		{Date{9999, 12, 31}, 2958465},
+	} {
+		if got := test.date.ExcelSerial(); got != test.serial {
+			t.Errorf("%v.ExcelSerial() = %d, want %d", test.date, got, test.serial)
+		}
+		if got := FromExcelSerial(test.serial); got != test.date {
+			t.Errorf("FromExcelSerial(%d) = %v, want %v", test.serial, got, test.date)
+		}
+	}
+}
+
+func TestUnix(t *testing.T) {
+	for _, test := range []struct {
+		sec  int64
+		want Date
+	}{
+		{0, Date{1970, 1, 1}},
+		{86399, Date{1970, 1, 1}},
+		{86400, Date{1970, 1, 2}},
+		{-1, Date{1969, 12, 31}},
+		{-86400, Date{1969, 12, 31}},
+		{-86401, Date{1969, 12, 30}},
+		{545443200, Date{1987, 4, 15}},
+		{545529599, Date{1987, 4, 15}},
+	} {
+		if got := DateOfUnix(test.sec); got != test.want {
+			t.Errorf("DateOfUnix(%d) = %v, want %v", test.sec, got, test.want)
+		}
+		if got, want := DateOfUnix(test.sec), DateOf(time.Unix(test.sec, 0).UTC()); got != want {
+			t.Errorf("DateOfUnix(%d) = %v, but time.Unix gives %v", test.sec, got, want)
+		}
+		if got := DateOfUnixMilli(test.sec * 1000); got != test.want {
+			t.Errorf("DateOfUnixMilli(%d) = %v, want %v", test.sec*1000, got, test.want)
+		}
+	}
+
+	for _, test := range []struct {
+		msec int64
+		want Date
+	}{
+		{-1, Date{1969, 12, 31}},
+		{86399999, Date{1970, 1, 1}},
+		{86400000, Date{1970, 1, 2}},
+	} {
+		if got := DateOfUnixMilli(test.msec); got != test.want {
+			t.Errorf("DateOfUnixMilli(%d) = %v, want %v", test.msec, got, test.want)
+		}
+	}
+
+	for _, d := range []Date{{1970, 1, 1}, {1969, 12, 31}, {1987, 4, 15}, {1, 1, 1}, {-1, 12, 31}} {
+		if got, want := d.Unix(), d.In(time.UTC).Unix(); got != want {
+			t.Errorf("%v.Unix() = %d, want %d", d, got, want)
+		}
+		if got, want := d.UnixMilli(), d.In(time.UTC).Unix()*1000; got != want {
+			t.Errorf("%v.UnixMilli() = %d, want %d", d, got, want)
+		}
+		if got := DateOfUnix(d.Unix()); got != d {
+			t.Errorf("DateOfUnix(%v.Unix()) = %v", d, got)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+module fknsrs.biz/p/civil/googlecivil
+
+go 1.24.0
+
+require (
+	cloud.google.com/go v0.123.0
+	fknsrs.biz/p/civil v0.0.0
+)
+
+replace fknsrs.biz/p/civil => ../
@@ -0,0 +1,25 @@
+// Package googlecivil converts dates between fknsrs.biz/p/civil and
+// cloud.google.com/go/civil.
+package googlecivil
+
+import (
+	gcivil "cloud.google.com/go/civil"
+
+	"fknsrs.biz/p/civil"
+)
+
+func FromDate(d gcivil.Date) civil.Date {
+	return civil.Date{Year: d.Year, Month: d.Month, Day: d.Day}
+}
+
+func ToDate(d civil.Date) gcivil.Date {
+	return gcivil.Date{Year: d.Year, Month: d.Month, Day: d.Day}
+}
+
+func FromTime(t gcivil.Time) civil.Time {
+	return civil.Time{Hour: t.Hour, Minute: t.Minute, Second: t.Second, Nanosecond: t.Nanosecond}
+}
+
+func ToTime(t civil.Time) gcivil.Time {
+	return gcivil.Time{Hour: t.Hour, Minute: t.Minute, Second: t.Second, Nanosecond: t.Nanosecond}
+}
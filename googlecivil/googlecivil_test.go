@@ -0,0 +1,47 @@
+package googlecivil
+
+import (
+	"testing"
+
+	gcivil "cloud.google.com/go/civil"
+
+	"fknsrs.biz/p/civil"
+)
+
+func TestDate(t *testing.T) {
+	for _, test := range []struct {
+		d  civil.Date
+		gd gcivil.Date
+	}{
+		{civil.Date{Year: 1987, Month: 4, Day: 15}, gcivil.Date{Year: 1987, Month: 4, Day: 15}},
+		{civil.Date{Year: -1, Month: 12, Day: 31}, gcivil.Date{Year: -1, Month: 12, Day: 31}},
+		{civil.Date{}, gcivil.Date{}},
+	} {
+		if got := ToDate(test.d); got != test.gd {
+			t.Errorf("ToDate(%v) = %v, want %v", test.d, got, test.gd)
+		}
+		if got := FromDate(test.gd); got != test.d {
+			t.Errorf("FromDate(%v) = %v, want %v", test.gd, got, test.d)
+		}
+		if got, want := ToDate(test.d).String(), test.d.String(); got != want {
+			t.Errorf("ToDate(%v).String() = %q, want %q", test.d, got, want)
+		}
+	}
+}
+
+func TestTime(t *testing.T) {
+	for _, test := range []struct {
+		t  civil.Time
+		gt gcivil.Time
+	}{
+		{civil.Time{Hour: 15, Minute: 4, Second: 5}, gcivil.Time{Hour: 15, Minute: 4, Second: 5}},
+		{civil.Time{Hour: 23, Minute: 59, Second: 59, Nanosecond: 999999999}, gcivil.Time{Hour: 23, Minute: 59, Second: 59, Nanosecond: 999999999}},
+	} {
+		if got := ToTime(test.t); got != test.gt {
+			t.Errorf("ToTime(%v) = %v, want %v", test.t, got, test.gt)
+		}
+		if got := FromTime(test.gt); got != test.t {
+			t.Errorf("FromTime(%v) = %v, want %v", test.gt, got, test.t)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+module fknsrs.biz/p/civil/gqldate
+
+go 1.18
+
+require (
+	fknsrs.biz/p/civil v0.0.0
+	github.com/99designs/gqlgen v0.17.41
+)
+
+require (
+	github.com/google/uuid v1.3.0 // indirect
+	github.com/sosodev/duration v1.1.0 // indirect
+	github.com/vektah/gqlparser/v2 v2.5.10 // indirect
+)
+
+replace fknsrs.biz/p/civil => ../
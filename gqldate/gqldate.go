@@ -0,0 +1,51 @@
+// Package gqldate provides a gqlgen scalar that sends and receives civil.Date
+// values as "2006-01-02" strings.
+//
+// To use it, map a scalar to civil.Date in gqlgen.yml:
+//
+//	models:
+//	  Date:
+//	    model: fknsrs.biz/p/civil/gqldate.Date
+package gqldate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"fknsrs.biz/p/civil"
+)
+
+// MarshalDate writes d as a quoted "2006-01-02" string. A date UnmarshalDate
+// couldn't read back, such as the zero Date or one outside years 0 to 9999, is
+// an error instead. Use a *civil.Date for a nullable field; gqlgen writes null for a nil
+// pointer itself.
+func MarshalDate(d civil.Date) graphql.ContextMarshaler {
+	return graphql.ContextWriterFunc(func(ctx context.Context, w io.Writer) error {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("gqldate.MarshalDate: %w", err)
+		}
+		if d.Year < 0 || d.Year > 9999 {
+			return fmt.Errorf("gqldate.MarshalDate: year %d doesn't fit in four digits", d.Year)
+		}
+		io.WriteString(w, strconv.Quote(d.String()))
+		return nil
+	})
+}
+
+// UnmarshalDate parses a date from a string input value with
+// civil.ParseDate. Any other kind of value, including a number, is an error.
+func UnmarshalDate(ctx context.Context, v interface{}) (civil.Date, error) {
+	switch v := v.(type) {
+	case string:
+		return civil.ParseDate(v)
+	case json.Number, int, int32, int64, float32, float64:
+		return civil.Date{}, fmt.Errorf("gqldate.UnmarshalDate: date must be a string, not the number %v", v)
+	default:
+		return civil.Date{}, fmt.Errorf("gqldate.UnmarshalDate: date must be a string, not %T", v)
+	}
+}
@@ -0,0 +1,65 @@
+package gqldate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"fknsrs.biz/p/civil"
+)
+
+func TestMarshalDate(t *testing.T) {
+	for _, test := range []struct {
+		d    civil.Date
+		want string
+		err  string
+	}{
+		{civil.Date{Year: 1987, Month: 4, Day: 15}, `"1987-04-15"`, ""},
+		{civil.Date{Year: 3, Month: 2, Day: 4}, `"0003-02-04"`, ""},
+		{civil.Date{}, "", "gqldate.MarshalDate: civil.Date.Validate: month out of range: 0"},
+		{civil.Date{Year: 10000, Month: 1, Day: 1}, "", "gqldate.MarshalDate: year 10000 doesn't fit in four digits"},
+		{civil.MaxDate, "", "gqldate.MarshalDate: year 2147483647 doesn't fit in four digits"},
+	} {
+		var b bytes.Buffer
+		err := MarshalDate(test.d).MarshalGQLContext(context.Background(), &b)
+		if got := b.String(); got != test.want {
+			t.Errorf("MarshalDate(%v) = %s, want %s", test.d, got, test.want)
+		}
+		if test.err == "" && err != nil {
+			t.Errorf("MarshalDate(%v) error = %v, want nil", test.d, err)
+		} else if test.err != "" && (err == nil || err.Error() != test.err) {
+			t.Errorf("MarshalDate(%v) error = %v, want %q", test.d, err, test.err)
+		}
+		if err == nil {
+			if _, err := UnmarshalDate(context.Background(), test.d.String()); err != nil {
+				t.Errorf("UnmarshalDate(%q): %v", test.d.String(), err)
+			}
+		}
+	}
+}
+
+func TestUnmarshalDate(t *testing.T) {
+	for _, test := range []struct {
+		v    interface{}
+		want civil.Date
+		err  string
+	}{
+		{"1987-04-15", civil.Date{Year: 1987, Month: 4, Day: 15}, ""},
+		{"1987-04-31", civil.Date{}, `civil.ParseDate: day out of range: "1987-04-31"`},
+		{json.Number("19870415"), civil.Date{}, "gqldate.UnmarshalDate: date must be a string, not the number 19870415"},
+		{int64(5), civil.Date{}, "gqldate.UnmarshalDate: date must be a string, not the number 5"},
+		{nil, civil.Date{}, "gqldate.UnmarshalDate: date must be a string, not <nil>"},
+		{true, civil.Date{}, "gqldate.UnmarshalDate: date must be a string, not bool"},
+	} {
+		got, err := UnmarshalDate(context.Background(), test.v)
+		if got != test.want {
+			t.Errorf("UnmarshalDate(%#v) = %v, want %v", test.v, got, test.want)
+		}
+		if test.err == "" && err != nil {
+			t.Errorf("UnmarshalDate(%#v) error = %v, want nil", test.v, err)
+		} else if test.err != "" && (err == nil || err.Error() != test.err) {
+			t.Errorf("UnmarshalDate(%#v) error = %v, want %q", test.v, err, test.err)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package civil
+
+import (
+	"time"
+)
+
+// USFederalHolidays returns the observed US federal holidays for year, in
+// date order. Holidays falling on a Saturday are observed on the preceding
+// Friday, and those falling on a Sunday on the following Monday. This means
+// that when New Year's Day is a Saturday, its observed date is December 31st
+// of the previous year. Juneteenth is only included from 2021 onwards.
+func USFederalHolidays(year int) []Date {
+	nth := func(month time.Month, weekday time.Weekday, n int) Date {
+		d, _ := NthWeekdayOfMonth(year, month, weekday, n)
+		return d
+	}
+
+	holidays := []Date{
+		observedUSHoliday(Date{year, time.January, 1}),
+		nth(time.January, time.Monday, 3),
+		nth(time.February, time.Monday, 3),
+		nth(time.May, time.Monday, -1),
+	}
+	if year >= 2021 {
+		holidays = append(holidays, observedUSHoliday(Date{year, time.June, 19}))
+	}
+
+	return append(holidays,
+		observedUSHoliday(Date{year, time.July, 4}),
+		nth(time.September, time.Monday, 1),
+		nth(time.October, time.Monday, 2),
+		observedUSHoliday(Date{year, time.November, 11}),
+		nth(time.November, time.Thursday, 4),
+		observedUSHoliday(Date{year, time.December, 25}),
+	)
+}
+
+func observedUSHoliday(d Date) Date {
+	switch d.Weekday() {
+	case time.Saturday:
+		return d.AddDays(-1)
+	case time.Sunday:
+		return d.AddDays(1)
+	}
+	return d
+}
+
+// Easter returns the date of Easter Sunday in the Gregorian calendar for
+// year, using the anonymous Gregorian (Meeus/Jones/Butcher) algorithm.
+func Easter(year int) Date {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return Date{Year: year, Month: time.Month(month), Day: day}
+}
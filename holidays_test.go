@@ -0,0 +1,59 @@
+package civil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUSFederalHolidays(t *testing.T) {
+	assert.Equal(t, []Date{
+		{2021, 1, 1},
+		{2021, 1, 18},
+		{2021, 2, 15},
+		{2021, 5, 31},
+		{2021, 6, 18},
+		{2021, 7, 5},
+		{2021, 9, 6},
+		{2021, 10, 11},
+		{2021, 11, 11},
+		{2021, 11, 25},
+		{2021, 12, 24},
+	}, USFederalHolidays(2021))
+
+	assert.Equal(t, []Date{
+		{2016, 1, 1},
+		{2016, 1, 18},
+		{2016, 2, 15},
+		{2016, 5, 30},
+		{2016, 7, 4},
+		{2016, 9, 5},
+		{2016, 10, 10},
+		{2016, 11, 11},
+		{2016, 11, 24},
+		{2016, 12, 26},
+	}, USFederalHolidays(2016))
+
+	assert.Equal(t, Date{2021, 12, 31}, USFederalHolidays(2022)[0])
+}
+
+func TestEaster(t *testing.T) {
+	for _, test := range []struct {
+		year int
+		want Date
+	}{
+		{1961, Date{1961, 4, 2}},
+		{2000, Date{2000, 4, 23}},
+		{2008, Date{2008, 3, 23}},
+		{2011, Date{2011, 4, 24}},
+		{2016, Date{2016, 3, 27}},
+		{2019, Date{2019, 4, 21}},
+		{2024, Date{2024, 3, 31}},
+		{2025, Date{2025, 4, 20}},
+		{2038, Date{2038, 4, 25}},
+	} {
+		if got := Easter(test.year); got != test.want {
+			t.Errorf("Easter(%d) = %v, want %v", test.year, got, test.want)
+		}
+	}
+}
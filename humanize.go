@@ -0,0 +1,52 @@
+package civil
+
+import (
+	"strconv"
+)
+
+// Humanize describes d relative to relativeTo in English, such as "today",
+// "tomorrow", "3 days ago" or "in 2 months". Differences of up to six days
+// are given in days, less than a month in whole weeks, less than a year in
+// whole months, and anything longer in whole years. Weeks, months and years
+// are always rounded down, so 13 days is "1 week".
+func (d Date) Humanize(relativeTo Date) string {
+	days := d.DaysSince(relativeTo)
+
+	switch days {
+	case 0:
+		return "today"
+	case 1:
+		return "tomorrow"
+	case -1:
+		return "yesterday"
+	}
+
+	p := d.Sub(relativeTo)
+	if days < 0 {
+		p, days = p.Negate(), -days
+	}
+
+	var n int
+	var unit string
+	switch {
+	case days < 7:
+		n, unit = days, "day"
+	case p.Years == 0 && p.Months == 0:
+		n, unit = days/7, "week"
+	case p.Years == 0:
+		n, unit = p.Months, "month"
+	default:
+		n, unit = p.Years, "year"
+	}
+
+	s := strconv.Itoa(n) + " " + unit
+	if n != 1 {
+		s += "s"
+	}
+
+	if d.Before(relativeTo) {
+		return s + " ago"
+	}
+
+	return "in " + s
+}
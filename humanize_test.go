@@ -0,0 +1,41 @@
+package civil
+
+import (
+	"testing"
+)
+
+func TestHumanize(t *testing.T) {
+	now := Date{2016, 3, 15}
+
+	for _, test := range []struct {
+		d    Date
+		want string
+	}{
+		{Date{2016, 3, 15}, "today"},
+		{Date{2016, 3, 16}, "tomorrow"},
+		{Date{2016, 3, 14}, "yesterday"},
+		{Date{2016, 3, 17}, "in 2 days"},
+		{Date{2016, 3, 13}, "2 days ago"},
+		{Date{2016, 3, 21}, "in 6 days"},
+		{Date{2016, 3, 9}, "6 days ago"},
+		{Date{2016, 3, 22}, "in 1 week"},
+		{Date{2016, 3, 8}, "1 week ago"},
+		{Date{2016, 3, 28}, "in 1 week"},
+		{Date{2016, 3, 29}, "in 2 weeks"},
+		{Date{2016, 4, 14}, "in 4 weeks"},
+		{Date{2016, 4, 15}, "in 1 month"},
+		{Date{2016, 2, 16}, "4 weeks ago"},
+		{Date{2016, 2, 15}, "1 month ago"},
+		{Date{2016, 12, 31}, "in 9 months"},
+		{Date{2017, 3, 14}, "in 11 months"},
+		{Date{2017, 3, 15}, "in 1 year"},
+		{Date{2015, 3, 16}, "11 months ago"},
+		{Date{2015, 3, 15}, "1 year ago"},
+		{Date{2026, 3, 14}, "in 9 years"},
+		{Date{1987, 4, 15}, "28 years ago"},
+	} {
+		if got := test.d.Humanize(now); got != test.want {
+			t.Errorf("%v.Humanize(%v) = %q, want %q", test.d, now, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package civil
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// JSONDays is a Date that's encoded in JSON as the number of days since
+// 1970-01-01, rather than as a "2006-01-02" string. Convert to and from Date
+// with JSONDays(d) and Date(j).
+type JSONDays Date
+
+func (j JSONDays) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Date(j).unixDays())
+}
+
+func (j *JSONDays) UnmarshalJSON(data []byte) error {
+	var n *int
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("civil.JSONDays.UnmarshalJSON: %w", err)
+	}
+	if n == nil {
+		// Like Date, reject null rather than decoding it as the epoch.
+		return fmt.Errorf("civil.JSONDays.UnmarshalJSON: can't unmarshal null")
+	}
+
+	*j = JSONDays(dateOfUnixDays(*n))
+
+	return nil
+}
+
+func (j JSONDays) String() string {
+	return Date(j).String()
+}
@@ -0,0 +1,53 @@
+package civil
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONDays(t *testing.T) {
+	for _, test := range []struct {
+		date Date
+		want string
+	}{
+		{Date{1970, 1, 1}, `0`},
+		{Date{1970, 1, 2}, `1`},
+		{Date{1969, 12, 31}, `-1`},
+		{Date{1987, 4, 15}, `6313`},
+		{Date{1, 1, 1}, `-719162`},
+	} {
+		b, err := json.Marshal(JSONDays(test.date))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := string(b); got != test.want {
+			t.Errorf("json.Marshal(JSONDays(%v)) = %s, want %s", test.date, got, test.want)
+		}
+
+		var got JSONDays
+		if err := json.Unmarshal([]byte(test.want), &got); err != nil {
+			t.Fatalf("json.Unmarshal(%s): %v", test.want, err)
+		}
+		if Date(got) != test.date {
+			t.Errorf("json.Unmarshal(%s) = %v, want %v", test.want, got, test.date)
+		}
+	}
+
+	b, err := json.Marshal(struct {
+		A Date
+		B JSONDays
+	}{Date{1970, 1, 2}, JSONDays{1970, 1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `{"A":"1970-01-02","B":1}`, string(b))
+
+	var j JSONDays
+	for _, bad := range []string{``, `"1970-01-02"`, `1.5`, `null`, `null x`} {
+		if json.Unmarshal([]byte(bad), &j) == nil {
+			t.Errorf("%q, JSONDays: got nil, want error", bad)
+		}
+	}
+}
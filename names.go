@@ -0,0 +1,79 @@
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// Names holds the display names used when rendering months and weekdays.
+// Months are indexed from January and weekdays from Sunday, matching
+// time.Month-1 and time.Weekday.
+type Names struct {
+	Months        [12]string
+	ShortMonths   [12]string
+	Weekdays      [7]string
+	ShortWeekdays [7]string
+}
+
+var EnglishNames = Names{
+	Months: [12]string{
+		"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December",
+	},
+	ShortMonths: [12]string{
+		"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+		"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+	},
+	Weekdays: [7]string{
+		"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+	},
+	ShortWeekdays: [7]string{
+		"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat",
+	},
+}
+
+func (d Date) MonthName() string {
+	return d.MonthNameWith(EnglishNames)
+}
+
+func (d Date) MonthShortName() string {
+	return d.MonthShortNameWith(EnglishNames)
+}
+
+func (d Date) WeekdayName() string {
+	return d.WeekdayNameWith(EnglishNames)
+}
+
+func (d Date) WeekdayShortName() string {
+	return d.WeekdayShortNameWith(EnglishNames)
+}
+
+// MonthNameWith returns the name of d's month from n. If the month isn't
+// from January to December, such as in the zero Date, the result is the same
+// as time.Month's String would give, e.g. "%!Month(0)".
+func (d Date) MonthNameWith(n Names) string {
+	if d.Month < time.January || d.Month > time.December {
+		return badMonthName(d.Month)
+	}
+	return n.Months[d.Month-1]
+}
+
+// MonthShortNameWith is like MonthNameWith, but uses n's short names.
+func (d Date) MonthShortNameWith(n Names) string {
+	if d.Month < time.January || d.Month > time.December {
+		return badMonthName(d.Month)
+	}
+	return n.ShortMonths[d.Month-1]
+}
+
+func (d Date) WeekdayNameWith(n Names) string {
+	return n.Weekdays[d.Weekday()]
+}
+
+func (d Date) WeekdayShortNameWith(n Names) string {
+	return n.ShortWeekdays[d.Weekday()]
+}
+
+func badMonthName(m time.Month) string {
+	return fmt.Sprintf("%%!Month(%d)", int(m))
+}
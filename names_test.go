@@ -0,0 +1,77 @@
+package civil
+
+import (
+	"testing"
+)
+
+func TestNames(t *testing.T) {
+	for _, test := range []struct {
+		date                  Date
+		month, monthShort     string
+		weekday, weekdayShort string
+	}{
+		{Date{2016, 1, 1}, "January", "Jan", "Friday", "Fri"},
+		{Date{2016, 3, 7}, "March", "Mar", "Monday", "Mon"},
+		{Date{2016, 12, 25}, "December", "Dec", "Sunday", "Sun"},
+	} {
+		if got := test.date.MonthName(); got != test.month {
+			t.Errorf("%#v.MonthName() = %q, want %q", test.date, got, test.month)
+		}
+		if got := test.date.MonthShortName(); got != test.monthShort {
+			t.Errorf("%#v.MonthShortName() = %q, want %q", test.date, got, test.monthShort)
+		}
+		if got := test.date.WeekdayName(); got != test.weekday {
+			t.Errorf("%#v.WeekdayName() = %q, want %q", test.date, got, test.weekday)
+		}
+		if got := test.date.WeekdayShortName(); got != test.weekdayShort {
+			t.Errorf("%#v.WeekdayShortName() = %q, want %q", test.date, got, test.weekdayShort)
+		}
+	}
+}
+
+func TestNamesWith(t *testing.T) {
+	german := EnglishNames
+	german.Months[2] = "März"
+	german.ShortMonths[2] = "Mär"
+	german.Weekdays[1] = "Montag"
+	german.ShortWeekdays[1] = "Mo"
+
+	d := Date{2016, 3, 7}
+	if got := d.MonthNameWith(german); got != "März" {
+		t.Errorf("%#v.MonthNameWith(german) = %q, want %q", d, got, "März")
+	}
+	if got := d.MonthShortNameWith(german); got != "Mär" {
+		t.Errorf("%#v.MonthShortNameWith(german) = %q, want %q", d, got, "Mär")
+	}
+	if got := d.WeekdayNameWith(german); got != "Montag" {
+		t.Errorf("%#v.WeekdayNameWith(german) = %q, want %q", d, got, "Montag")
+	}
+	if got := d.WeekdayShortNameWith(german); got != "Mo" {
+		t.Errorf("%#v.WeekdayShortNameWith(german) = %q, want %q", d, got, "Mo")
+	}
+	if got := d.MonthName(); got != "March" {
+		t.Errorf("%#v.MonthName() = %q after overriding a copy, want %q", d, got, "March")
+	}
+}
+
+func TestMonthNameOutOfRange(t *testing.T) {
+	for _, test := range []struct {
+		date Date
+		want string
+	}{
+		{Date{}, "%!Month(0)"},
+		{Date{2016, 13, 1}, "%!Month(13)"},
+		{Date{2016, -1, 1}, "%!Month(-1)"},
+	} {
+		if got := test.date.MonthName(); got != test.want {
+			t.Errorf("%#v.MonthName() = %q, want %q", test.date, got, test.want)
+		}
+		if got := test.date.MonthShortName(); got != test.want {
+			t.Errorf("%#v.MonthShortName() = %q, want %q", test.date, got, test.want)
+		}
+	}
+
+	if got, want := (Date{}).Strftime("%B %b"), "%!Month(0) %!Month(0)"; got != want {
+		t.Errorf("Date{}.Strftime(%q) = %q, want %q", "%B %b", got, want)
+	}
+}
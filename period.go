@@ -0,0 +1,139 @@
+package civil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Period is an amount of calendar time expressed in years, months and days.
+// Unlike a time.Duration, the number of days a Period covers depends on the
+// date it's applied to.
+type Period struct {
+	Years, Months, Days int
+}
+
+// AddPeriod returns the date p after d. Years and months are applied first
+// using the same clamping rules as AddMonths, then days are added.
+func (d Date) AddPeriod(p Period) Date {
+	return d.AddDate(p.Years, p.Months, p.Days)
+}
+
+// Sub returns the calendar period between other and d, so that
+// other.AddPeriod(d.Sub(other)) == d. The period is made up of as many whole
+// months as fit between the two dates, counting from other, with the
+// remainder in days. If d is before other, the months are counted backwards
+// from other and every component of the result is negative or zero.
+func (d Date) Sub(other Date) Period {
+	months := other.MonthsUntil(d)
+	if d.Before(other) {
+		if other.AddMonths(months).Before(d) {
+			months++
+		}
+	} else if other.AddMonths(months).After(d) {
+		months--
+	}
+
+	return Period{
+		Years:  months / 12,
+		Months: months % 12,
+		Days:   d.DaysSince(other.AddMonths(months)),
+	}
+}
+
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+func (p Period) Negate() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Days: -p.Days}
+}
+
+// String returns p in ISO 8601 duration format, e.g. "P1Y2M10D". Zero
+// components are omitted, and the zero Period is "P0D". Negative components
+// carry their own sign, e.g. "P-1Y2M".
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+
+	b := []byte{'P'}
+	if p.Years != 0 {
+		b = append(strconv.AppendInt(b, int64(p.Years), 10), 'Y')
+	}
+	if p.Months != 0 {
+		b = append(strconv.AppendInt(b, int64(p.Months), 10), 'M')
+	}
+	if p.Days != 0 {
+		b = append(strconv.AppendInt(b, int64(p.Days), 10), 'D')
+	}
+
+	return string(b)
+}
+
+// ParsePeriod parses an ISO 8601 date-only duration such as "P1Y2M10D". Weeks
+// ("P2W") are accepted and converted to days. Each component may be signed,
+// and a leading sign negates the whole period, so "-P1Y2M" is equivalent to
+// "P-1Y-2M". Time components ("PT1H") aren't supported.
+func ParsePeriod(s string) (Period, error) {
+	var p Period
+
+	rest := s
+	negate := false
+	if strings.HasPrefix(rest, "-") {
+		negate = true
+		rest = rest[1:]
+	} else if strings.HasPrefix(rest, "+") {
+		rest = rest[1:]
+	}
+
+	if !strings.HasPrefix(rest, "P") || len(rest) == 1 {
+		return Period{}, fmt.Errorf("civil.ParsePeriod: invalid period %q", s)
+	}
+	rest = rest[1:]
+
+	const order = "YMWD"
+	last := -1
+	for rest != "" {
+		i := 0
+		if rest[0] == '-' || rest[0] == '+' {
+			i++
+		}
+		for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+			i++
+		}
+		if i == len(rest) {
+			return Period{}, fmt.Errorf("civil.ParsePeriod: invalid period %q", s)
+		}
+
+		n, err := strconv.Atoi(rest[:i])
+		if err != nil {
+			return Period{}, fmt.Errorf("civil.ParsePeriod: invalid period %q", s)
+		}
+
+		idx := strings.IndexByte(order, rest[i])
+		if idx <= last {
+			return Period{}, fmt.Errorf("civil.ParsePeriod: invalid period %q", s)
+		}
+		last = idx
+
+		switch rest[i] {
+		case 'Y':
+			p.Years = n
+		case 'M':
+			p.Months = n
+		case 'W':
+			p.Days += n * 7
+		case 'D':
+			p.Days += n
+		}
+
+		rest = rest[i+1:]
+	}
+
+	if negate {
+		p = p.Negate()
+	}
+
+	return p, nil
+}
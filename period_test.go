@@ -0,0 +1,210 @@
+package civil
+
+import (
+	"testing"
+)
+
+func TestAddPeriod(t *testing.T) {
+	for _, test := range []struct {
+		desc   string
+		start  Date
+		period Period
+		end    Date
+	}{
+		{
+			desc:   "zero period noop",
+			start:  Date{2014, 5, 9},
+			period: Period{},
+			end:    Date{2014, 5, 9},
+		},
+		{
+			desc:   "years months and days",
+			start:  Date{2014, 5, 9},
+			period: Period{1, 2, 10},
+			end:    Date{2015, 7, 19},
+		},
+		{
+			desc:   "clamps before adding days",
+			start:  Date{2014, 1, 31},
+			period: Period{0, 1, 1},
+			end:    Date{2014, 3, 1},
+		},
+		{
+			desc:   "negative period",
+			start:  Date{2014, 5, 9},
+			period: Period{-1, -2, -10},
+			end:    Date{2013, 2, 27},
+		},
+	} {
+		if got := test.start.AddPeriod(test.period); got != test.end {
+			t.Errorf("[%s] %#v.AddPeriod(%v) = %#v, want %#v", test.desc, test.start, test.period, got, test.end)
+		}
+	}
+}
+
+func TestPeriodString(t *testing.T) {
+	for _, test := range []struct {
+		period Period
+		want   string
+	}{
+		{Period{}, "P0D"},
+		{Period{1, 2, 10}, "P1Y2M10D"},
+		{Period{1, 0, 0}, "P1Y"},
+		{Period{0, 3, 0}, "P3M"},
+		{Period{0, 0, 14}, "P14D"},
+		{Period{-1, 2, 0}, "P-1Y2M"},
+	} {
+		if got := test.period.String(); got != test.want {
+			t.Errorf("%#v.String() = %q, want %q", test.period, got, test.want)
+		}
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	for _, test := range []struct {
+		str  string
+		want Period
+		err  bool
+	}{
+		{str: "P0D", want: Period{}},
+		{str: "P1Y2M10D", want: Period{1, 2, 10}},
+		{str: "P1Y", want: Period{1, 0, 0}},
+		{str: "P3M", want: Period{0, 3, 0}},
+		{str: "P2W", want: Period{0, 0, 14}},
+		{str: "P1W3D", want: Period{0, 0, 10}},
+		{str: "P-1Y2M", want: Period{-1, 2, 0}},
+		{str: "-P1Y2M", want: Period{-1, -2, 0}},
+		{str: "+P1D", want: Period{0, 0, 1}},
+		{str: "", err: true},
+		{str: "P", err: true},
+		{str: "1Y", err: true},
+		{str: "P1", err: true},
+		{str: "PY", err: true},
+		{str: "P1D1Y", err: true},
+		{str: "P1Y1Y", err: true},
+		{str: "PT1H", err: true},
+		{str: "P1X", err: true},
+	} {
+		got, err := ParsePeriod(test.str)
+		if (err != nil) != test.err {
+			t.Errorf("ParsePeriod(%q): got error %v, want error %t", test.str, err, test.err)
+		}
+		if got != test.want {
+			t.Errorf("ParsePeriod(%q) = %+v, want %+v", test.str, got, test.want)
+		}
+	}
+
+	for _, p := range []Period{{}, {1, 2, 10}, {-1, 2, -3}, {0, 0, 400}} {
+		got, err := ParsePeriod(p.String())
+		if err != nil {
+			t.Errorf("ParsePeriod(%q): unexpected error %v", p.String(), err)
+		}
+		if got != p {
+			t.Errorf("ParsePeriod(%q) = %+v, want %+v", p.String(), got, p)
+		}
+	}
+}
+
+func TestSub(t *testing.T) {
+	for _, test := range []struct {
+		desc       string
+		start, end Date
+		want       Period
+	}{
+		{
+			desc:  "same day",
+			start: Date{2016, 1, 15},
+			end:   Date{2016, 1, 15},
+			want:  Period{},
+		},
+		{
+			desc:  "borrowing days across a leap february",
+			start: Date{2019, 1, 15},
+			end:   Date{2020, 3, 1},
+			want:  Period{1, 1, 15},
+		},
+		{
+			desc:  "borrowing days across a normal february",
+			start: Date{2018, 1, 15},
+			end:   Date{2019, 3, 1},
+			want:  Period{1, 1, 14},
+		},
+		{
+			desc:  "whole years",
+			start: Date{2012, 2, 29},
+			end:   Date{2016, 2, 29},
+			want:  Period{4, 0, 0},
+		},
+		{
+			desc:  "end of month to shorter end of month",
+			start: Date{2014, 1, 31},
+			end:   Date{2014, 2, 28},
+			want:  Period{0, 1, 0},
+		},
+		{
+			desc:  "end of month to later day of next month",
+			start: Date{2014, 1, 31},
+			end:   Date{2014, 3, 1},
+			want:  Period{0, 1, 1},
+		},
+		{
+			desc:  "less than a month",
+			start: Date{2014, 1, 31},
+			end:   Date{2014, 2, 27},
+			want:  Period{0, 0, 27},
+		},
+		{
+			desc:  "crossing a year boundary",
+			start: Date{2015, 12, 20},
+			end:   Date{2016, 1, 10},
+			want:  Period{0, 0, 21},
+		},
+		{
+			desc:  "reversed",
+			start: Date{2020, 3, 1},
+			end:   Date{2019, 1, 15},
+			want:  Period{-1, -1, -17},
+		},
+		{
+			desc:  "reversed end of month to shorter end of month",
+			start: Date{2019, 3, 31},
+			end:   Date{2019, 2, 28},
+			want:  Period{0, -1, 0},
+		},
+		{
+			desc:  "reversed end of month to earlier day of shorter month",
+			start: Date{2019, 3, 31},
+			end:   Date{2019, 2, 25},
+			want:  Period{0, -1, -3},
+		},
+		{
+			desc:  "reversed less than a month",
+			start: Date{2019, 3, 31},
+			end:   Date{2019, 3, 1},
+			want:  Period{0, 0, -30},
+		},
+		{
+			desc:  "reversed whole years from leap day",
+			start: Date{2016, 2, 29},
+			end:   Date{2015, 2, 28},
+			want:  Period{-1, 0, 0},
+		},
+	} {
+		got := test.end.Sub(test.start)
+		if got != test.want {
+			t.Errorf("[%s] %#v.Sub(%#v) = %+v, want %+v", test.desc, test.end, test.start, got, test.want)
+		}
+		if back := test.start.AddPeriod(got); back != test.end {
+			t.Errorf("[%s] %#v.AddPeriod(%+v) = %#v, want %#v", test.desc, test.start, got, back, test.end)
+		}
+	}
+
+	// The round trip holds in both directions for every pair of dates.
+	for a := (Date{2019, 1, 25}); a.Before(Date{2019, 4, 5}); a = a.AddDays(1) {
+		for b := (Date{2019, 1, 25}); b.Before(Date{2019, 4, 5}); b = b.AddDays(1) {
+			if back := a.AddPeriod(b.Sub(a)); back != b {
+				t.Errorf("%#v.AddPeriod(%#v.Sub(%#v)) = %#v, want %#v", a, b, a, back, b)
+			}
+		}
+	}
+}
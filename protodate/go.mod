@@ -0,0 +1,11 @@
+module fknsrs.biz/p/civil/protodate
+
+go 1.23.0
+
+require (
+	fknsrs.biz/p/civil v0.0.0
+	google.golang.org/genproto v0.0.0-20250603155806-513f23925822
+	google.golang.org/protobuf v1.36.6
+)
+
+replace fknsrs.biz/p/civil => ../
@@ -0,0 +1,46 @@
+// Package protodate converts civil.Date values to and from the
+// google.type.Date protobuf message.
+package protodate
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/type/date"
+
+	"fknsrs.biz/p/civil"
+)
+
+// ToProto converts d to a google.type.Date. The protobuf message only
+// supports years 1 through 9999, so dates outside that range produce a
+// message other implementations may reject.
+func ToProto(d civil.Date) *date.Date {
+	return &date.Date{Year: int32(d.Year), Month: int32(d.Month), Day: int32(d.Day)}
+}
+
+// FromProto converts a google.type.Date to a civil.Date. google.type.Date
+// uses zero to mean a year, month or day is unspecified, so it can describe
+// things like anniversaries or credit card expiry months. A civil.Date is
+// always a full date, so any zero field is an error, as is a nil message or
+// one that describes a day that doesn't exist.
+func FromProto(p *date.Date) (civil.Date, error) {
+	if p == nil {
+		return civil.Date{}, fmt.Errorf("protodate.FromProto: nil date")
+	}
+
+	switch {
+	case p.Year == 0:
+		return civil.Date{}, fmt.Errorf("protodate.FromProto: year is unspecified")
+	case p.Month == 0:
+		return civil.Date{}, fmt.Errorf("protodate.FromProto: month is unspecified")
+	case p.Day == 0:
+		return civil.Date{}, fmt.Errorf("protodate.FromProto: day is unspecified")
+	}
+
+	d := civil.Date{Year: int(p.Year), Month: time.Month(p.Month), Day: int(p.Day)}
+	if !d.IsValid() {
+		return civil.Date{}, fmt.Errorf("protodate.FromProto: %v is not a valid date", d)
+	}
+
+	return d, nil
+}
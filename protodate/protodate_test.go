@@ -0,0 +1,50 @@
+package protodate
+
+import (
+	"testing"
+
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/proto"
+
+	"fknsrs.biz/p/civil"
+)
+
+func TestToProto(t *testing.T) {
+	for _, test := range []struct {
+		d    civil.Date
+		want *date.Date
+	}{
+		{civil.Date{Year: 1987, Month: 4, Day: 15}, &date.Date{Year: 1987, Month: 4, Day: 15}},
+		{civil.Date{Year: 1, Month: 1, Day: 1}, &date.Date{Year: 1, Month: 1, Day: 1}},
+		{civil.Date{Year: 9999, Month: 12, Day: 31}, &date.Date{Year: 9999, Month: 12, Day: 31}},
+	} {
+		if got := ToProto(test.d); !proto.Equal(got, test.want) {
+			t.Errorf("ToProto(%v) = %v, want %v", test.d, got, test.want)
+		}
+	}
+}
+
+func TestFromProto(t *testing.T) {
+	for _, test := range []struct {
+		p    *date.Date
+		want civil.Date // if empty, expect an error
+	}{
+		{&date.Date{Year: 1987, Month: 4, Day: 15}, civil.Date{Year: 1987, Month: 4, Day: 15}},
+		{&date.Date{Year: 2016, Month: 2, Day: 29}, civil.Date{Year: 2016, Month: 2, Day: 29}},
+		{&date.Date{Year: 2015, Month: 2, Day: 29}, civil.Date{}},
+		{&date.Date{Year: 2016, Month: 13, Day: 1}, civil.Date{}},
+		{&date.Date{Year: 0, Month: 4, Day: 15}, civil.Date{}},
+		{&date.Date{Year: 1987, Month: 0, Day: 0}, civil.Date{}},
+		{&date.Date{Year: 1987, Month: 4, Day: 0}, civil.Date{}},
+		{&date.Date{}, civil.Date{}},
+		{nil, civil.Date{}},
+	} {
+		got, err := FromProto(test.p)
+		if got != test.want {
+			t.Errorf("FromProto(%v) = %v, want %v", test.p, got, test.want)
+		}
+		if (err != nil) != (test.want == civil.Date{}) {
+			t.Errorf("FromProto(%v): unexpected error state %v", test.p, err)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package civil
+
+import (
+	"sort"
+)
+
+// DateRange is a span of dates. Both Start and End are part of the range, so
+// a range where Start and End are the same date covers exactly one day. A
+// range where End is before Start is empty.
+type DateRange struct {
+	Start, End Date
+}
+
+// NewDateRange returns the range covering a through b. If b is before a, the
+// two are swapped so the result is never empty.
+func NewDateRange(a, b Date) DateRange {
+	if b.Before(a) {
+		a, b = b, a
+	}
+	return DateRange{Start: a, End: b}
+}
+
+func (r DateRange) IsEmpty() bool {
+	return r.End.Before(r.Start)
+}
+
+// Len returns the number of days in r, counting both Start and End, so a
+// range where Start and End are the same date has a length of 1. Empty
+// ranges have a length of 0.
+func (r DateRange) Len() int {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.End.DaysSince(r.Start) + 1
+}
+
+func (r DateRange) Contains(d Date) bool {
+	return d.AfterOrOn(r.Start) && d.BeforeOrOn(r.End)
+}
+
+// ClampToRange is equivalent to d.Clamp(r.Start, r.End), so an empty range
+// is treated as if its ends were swapped.
+func (d Date) ClampToRange(r DateRange) Date {
+	return d.Clamp(r.Start, r.End)
+}
+
+func (r DateRange) String() string {
+	return r.Start.String() + "/" + r.End.String()
+}
+
+// Overlaps reports whether r and other have at least one day in common.
+// Since both ends of a range are inclusive, a range ending on the day
+// another starts overlaps it, but one ending the day before doesn't.
+func (r DateRange) Overlaps(other DateRange) bool {
+	_, ok := r.Intersection(other)
+	return ok
+}
+
+// Intersection returns the days that r and other have in common, and
+// whether there are any.
+func (r DateRange) Intersection(other DateRange) (DateRange, bool) {
+	if r.IsEmpty() || other.IsEmpty() {
+		return DateRange{}, false
+	}
+
+	i := DateRange{Start: Max(r.Start, other.Start), End: Min(r.End, other.End)}
+	if i.IsEmpty() {
+		return DateRange{}, false
+	}
+
+	return i, true
+}
+
+// Split divides r into consecutive ranges of maxDays days each, in order,
+// with the last one being shorter if r's length isn't a multiple of maxDays.
+// An empty range has no parts. Split panics if maxDays is less than one.
+func (r DateRange) Split(maxDays int) []DateRange {
+	if maxDays < 1 {
+		panic("civil.DateRange.Split: maxDays must be at least one")
+	}
+
+	var l []DateRange
+	for start := r.Start; start.BeforeOrOn(r.End); start = start.AddDays(maxDays) {
+		l = append(l, DateRange{Start: start, End: Min(start.AddDays(maxDays-1), r.End)})
+	}
+	return l
+}
+
+// MergeRanges returns the smallest set of ranges covering the same days as
+// ranges, sorted by Start. Ranges that overlap or are adjacent, with one
+// ending the day before another starts, are merged. Empty ranges are
+// dropped. ranges itself isn't modified.
+func MergeRanges(ranges []DateRange) []DateRange {
+	var l []DateRange
+	for _, r := range ranges {
+		if !r.IsEmpty() {
+			l = append(l, r)
+		}
+	}
+
+	sort.Slice(l, func(i, j int) bool { return l[i].Start.Before(l[j].Start) })
+
+	var merged []DateRange
+	for _, r := range l {
+		if n := len(merged); n > 0 && r.Start.BeforeOrOn(merged[n-1].End.AddDays(1)) {
+			merged[n-1].End = Max(merged[n-1].End, r.End)
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	return merged
+}
+
+// MonthStarts returns the first day of every month from the month containing
+// start through the month containing end.
+func MonthStarts(start, end Date) []Date {
+	var l []Date
+	for d := (Date{Year: start.Year, Month: start.Month, Day: 1}); !d.After(end); d = d.AddMonths(1) {
+		l = append(l, d)
+	}
+	return l
+}
@@ -0,0 +1,53 @@
+//go:build go1.23
+
+package civil
+
+import (
+	"iter"
+)
+
+// Days returns an iterator over every date in r, from Start through End.
+func (r DateRange) Days() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		for d := r.Start; d.BeforeOrOn(r.End); d = d.AddDays(1) {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// EachMonth returns an iterator over the first day of every month from the
+// month containing start through the month containing end. It yields the
+// same dates as MonthStarts.
+func EachMonth(start, end Date) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		for d := (Date{Year: start.Year, Month: start.Month, Day: 1}); !d.After(end); d = d.AddMonths(1) {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// Walk returns an iterator over every step'th date in r. A positive step
+// walks forwards from Start, and a negative step walks backwards from End.
+// A step of zero yields nothing.
+func (r DateRange) Walk(step int) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		switch {
+		case step > 0:
+			for d := r.Start; d.BeforeOrOn(r.End); d = d.AddDays(step) {
+				if !yield(d) {
+					return
+				}
+			}
+		case step < 0:
+			for d := r.End; d.AfterOrOn(r.Start); d = d.AddDays(step) {
+				if !yield(d) {
+					return
+				}
+			}
+		}
+	}
+}
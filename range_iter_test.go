@@ -0,0 +1,115 @@
+//go:build go1.23
+
+package civil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateRangeDays(t *testing.T) {
+	for _, test := range []struct {
+		r    DateRange
+		want []Date
+	}{
+		{
+			r:    DateRange{Date{2016, 2, 27}, Date{2016, 3, 1}},
+			want: []Date{{2016, 2, 27}, {2016, 2, 28}, {2016, 2, 29}, {2016, 3, 1}},
+		},
+		{
+			r:    DateRange{Date{2015, 12, 31}, Date{2016, 1, 1}},
+			want: []Date{{2015, 12, 31}, {2016, 1, 1}},
+		},
+		{
+			r:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 1}},
+			want: []Date{{2016, 1, 1}},
+		},
+		{
+			r:    DateRange{Date{2016, 1, 2}, Date{2016, 1, 1}},
+			want: nil,
+		},
+	} {
+		var got []Date
+		for d := range test.r.Days() {
+			got = append(got, d)
+		}
+		assert.Equal(t, test.want, got, "%v.Days()", test.r)
+	}
+}
+
+func TestDateRangeDaysBreak(t *testing.T) {
+	var got []Date
+	for d := range (DateRange{Date{2016, 1, 1}, Date{2016, 12, 31}}).Days() {
+		if d.Day == 4 {
+			break
+		}
+		got = append(got, d)
+	}
+	assert.Equal(t, []Date{{2016, 1, 1}, {2016, 1, 2}, {2016, 1, 3}}, got)
+}
+
+func TestEachMonth(t *testing.T) {
+	for _, test := range monthStartsCases {
+		var got []Date
+		for d := range EachMonth(test.start, test.end) {
+			got = append(got, d)
+		}
+		assert.Equal(t, test.want, got, "EachMonth(%v, %v)", test.start, test.end)
+	}
+}
+
+func TestDateRangeWalk(t *testing.T) {
+	for _, test := range []struct {
+		r    DateRange
+		step int
+		want []Date
+	}{
+		{
+			r:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 29}},
+			step: 14,
+			want: []Date{{2016, 1, 1}, {2016, 1, 15}, {2016, 1, 29}},
+		},
+		{
+			r:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 28}},
+			step: 14,
+			want: []Date{{2016, 1, 1}, {2016, 1, 15}},
+		},
+		{
+			r:    DateRange{Date{2015, 12, 25}, Date{2016, 1, 10}},
+			step: 7,
+			want: []Date{{2015, 12, 25}, {2016, 1, 1}, {2016, 1, 8}},
+		},
+		{
+			r:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 29}},
+			step: -14,
+			want: []Date{{2016, 1, 29}, {2016, 1, 15}, {2016, 1, 1}},
+		},
+		{
+			r:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 20}},
+			step: -7,
+			want: []Date{{2016, 1, 20}, {2016, 1, 13}, {2016, 1, 6}},
+		},
+		{
+			r:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 1}},
+			step: 1,
+			want: []Date{{2016, 1, 1}},
+		},
+		{
+			r:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 29}},
+			step: 0,
+			want: nil,
+		},
+		{
+			r:    DateRange{Date{2016, 1, 2}, Date{2016, 1, 1}},
+			step: -1,
+			want: nil,
+		},
+	} {
+		var got []Date
+		for d := range test.r.Walk(test.step) {
+			got = append(got, d)
+		}
+		assert.Equal(t, test.want, got, "%v.Walk(%d)", test.r, test.step)
+	}
+}
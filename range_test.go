@@ -0,0 +1,353 @@
+package civil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDateRange(t *testing.T) {
+	for _, test := range []struct {
+		a, b Date
+		want DateRange
+	}{
+		{Date{2016, 1, 1}, Date{2016, 1, 31}, DateRange{Date{2016, 1, 1}, Date{2016, 1, 31}}},
+		{Date{2016, 1, 31}, Date{2016, 1, 1}, DateRange{Date{2016, 1, 1}, Date{2016, 1, 31}}},
+		{Date{2016, 1, 1}, Date{2016, 1, 1}, DateRange{Date{2016, 1, 1}, Date{2016, 1, 1}}},
+	} {
+		if got := NewDateRange(test.a, test.b); got != test.want {
+			t.Errorf("NewDateRange(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestDateRangeContains(t *testing.T) {
+	for _, test := range []struct {
+		r    DateRange
+		d    Date
+		want bool
+	}{
+		{DateRange{Date{2016, 1, 1}, Date{2016, 1, 31}}, Date{2016, 1, 15}, true},
+		{DateRange{Date{2016, 1, 1}, Date{2016, 1, 31}}, Date{2016, 1, 1}, true},
+		{DateRange{Date{2016, 1, 1}, Date{2016, 1, 31}}, Date{2016, 1, 31}, true},
+		{DateRange{Date{2016, 1, 1}, Date{2016, 1, 31}}, Date{2015, 12, 31}, false},
+		{DateRange{Date{2016, 1, 1}, Date{2016, 1, 31}}, Date{2016, 2, 1}, false},
+		{DateRange{Date{2016, 1, 1}, Date{2016, 1, 1}}, Date{2016, 1, 1}, true},
+		{DateRange{Date{2016, 1, 31}, Date{2016, 1, 1}}, Date{2016, 1, 15}, false},
+	} {
+		if got := test.r.Contains(test.d); got != test.want {
+			t.Errorf("%v.Contains(%v) = %t, want %t", test.r, test.d, got, test.want)
+		}
+	}
+}
+
+func TestDateRangeIsEmpty(t *testing.T) {
+	for _, test := range []struct {
+		r    DateRange
+		want bool
+	}{
+		{DateRange{Date{2016, 1, 1}, Date{2016, 1, 31}}, false},
+		{DateRange{Date{2016, 1, 1}, Date{2016, 1, 1}}, false},
+		{DateRange{Date{2016, 1, 2}, Date{2016, 1, 1}}, true},
+	} {
+		if got := test.r.IsEmpty(); got != test.want {
+			t.Errorf("%v.IsEmpty() = %t, want %t", test.r, got, test.want)
+		}
+	}
+}
+
+func TestDateRangeLen(t *testing.T) {
+	for _, test := range []struct {
+		r    DateRange
+		want int
+	}{
+		{DateRange{Date{2016, 1, 1}, Date{2016, 1, 1}}, 1},
+		{DateRange{Date{2016, 1, 1}, Date{2016, 1, 31}}, 31},
+		{DateRange{Date{2016, 2, 1}, Date{2016, 3, 1}}, 30},
+		{DateRange{Date{2016, 1, 1}, Date{2016, 12, 31}}, 366},
+		{DateRange{Date{2015, 12, 31}, Date{2016, 1, 1}}, 2},
+		{DateRange{Date{2016, 1, 2}, Date{2016, 1, 1}}, 0},
+		{DateRange{Date{2016, 12, 31}, Date{2016, 1, 1}}, 0},
+	} {
+		if got := test.r.Len(); got != test.want {
+			t.Errorf("%v.Len() = %d, want %d", test.r, got, test.want)
+		}
+	}
+}
+
+func TestDateRangeIntersection(t *testing.T) {
+	for _, test := range []struct {
+		desc string
+		a, b DateRange
+		want DateRange
+		ok   bool
+	}{
+		{
+			desc: "partial overlap",
+			a:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 20}},
+			b:    DateRange{Date{2016, 1, 10}, Date{2016, 1, 31}},
+			want: DateRange{Date{2016, 1, 10}, Date{2016, 1, 20}},
+			ok:   true,
+		},
+		{
+			desc: "fully contained",
+			a:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 31}},
+			b:    DateRange{Date{2016, 1, 10}, Date{2016, 1, 20}},
+			want: DateRange{Date{2016, 1, 10}, Date{2016, 1, 20}},
+			ok:   true,
+		},
+		{
+			desc: "identical",
+			a:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 31}},
+			b:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 31}},
+			want: DateRange{Date{2016, 1, 1}, Date{2016, 1, 31}},
+			ok:   true,
+		},
+		{
+			desc: "touching on one day",
+			a:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 10}},
+			b:    DateRange{Date{2016, 1, 10}, Date{2016, 1, 20}},
+			want: DateRange{Date{2016, 1, 10}, Date{2016, 1, 10}},
+			ok:   true,
+		},
+		{
+			desc: "adjacent",
+			a:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 10}},
+			b:    DateRange{Date{2016, 1, 11}, Date{2016, 1, 20}},
+		},
+		{
+			desc: "disjoint",
+			a:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 10}},
+			b:    DateRange{Date{2016, 2, 1}, Date{2016, 2, 10}},
+		},
+		{
+			desc: "empty range inside another",
+			a:    DateRange{Date{2016, 1, 1}, Date{2016, 1, 31}},
+			b:    DateRange{Date{2016, 1, 20}, Date{2016, 1, 10}},
+		},
+	} {
+		for _, pair := range [][2]DateRange{{test.a, test.b}, {test.b, test.a}} {
+			got, ok := pair[0].Intersection(pair[1])
+			if got != test.want || ok != test.ok {
+				t.Errorf("[%s] %v.Intersection(%v) = %v, %t, want %v, %t", test.desc, pair[0], pair[1], got, ok, test.want, test.ok)
+			}
+			if got := pair[0].Overlaps(pair[1]); got != test.ok {
+				t.Errorf("[%s] %v.Overlaps(%v) = %t, want %t", test.desc, pair[0], pair[1], got, test.ok)
+			}
+		}
+	}
+}
+
+var monthStartsCases = []struct {
+	start, end Date
+	want       []Date
+}{
+	{
+		start: Date{2016, 1, 1},
+		end:   Date{2016, 3, 1},
+		want:  []Date{{2016, 1, 1}, {2016, 2, 1}, {2016, 3, 1}},
+	},
+	{
+		start: Date{2016, 1, 15},
+		end:   Date{2016, 3, 10},
+		want:  []Date{{2016, 1, 1}, {2016, 2, 1}, {2016, 3, 1}},
+	},
+	{
+		start: Date{2015, 11, 30},
+		end:   Date{2016, 2, 29},
+		want:  []Date{{2015, 11, 1}, {2015, 12, 1}, {2016, 1, 1}, {2016, 2, 1}},
+	},
+	{
+		start: Date{2016, 1, 15},
+		end:   Date{2016, 1, 20},
+		want:  []Date{{2016, 1, 1}},
+	},
+	{
+		start: Date{2016, 2, 1},
+		end:   Date{2016, 1, 31},
+		want:  nil,
+	},
+}
+
+func TestDateRangeSplit(t *testing.T) {
+	for _, test := range []struct {
+		r       DateRange
+		maxDays int
+		want    []DateRange
+	}{
+		{
+			DateRange{Date{2016, 1, 1}, Date{2016, 1, 10}},
+			4,
+			[]DateRange{
+				{Date{2016, 1, 1}, Date{2016, 1, 4}},
+				{Date{2016, 1, 5}, Date{2016, 1, 8}},
+				{Date{2016, 1, 9}, Date{2016, 1, 10}},
+			},
+		},
+		{
+			DateRange{Date{2016, 2, 25}, Date{2016, 3, 2}},
+			7,
+			[]DateRange{
+				{Date{2016, 2, 25}, Date{2016, 3, 2}},
+			},
+		},
+		{
+			DateRange{Date{2016, 2, 25}, Date{2016, 3, 2}},
+			3,
+			[]DateRange{
+				{Date{2016, 2, 25}, Date{2016, 2, 27}},
+				{Date{2016, 2, 28}, Date{2016, 3, 1}},
+				{Date{2016, 3, 2}, Date{2016, 3, 2}},
+			},
+		},
+		{
+			DateRange{Date{2016, 1, 1}, Date{2016, 1, 3}},
+			1,
+			[]DateRange{
+				{Date{2016, 1, 1}, Date{2016, 1, 1}},
+				{Date{2016, 1, 2}, Date{2016, 1, 2}},
+				{Date{2016, 1, 3}, Date{2016, 1, 3}},
+			},
+		},
+		{
+			DateRange{Date{2016, 1, 1}, Date{2016, 1, 1}},
+			30,
+			[]DateRange{
+				{Date{2016, 1, 1}, Date{2016, 1, 1}},
+			},
+		},
+		{
+			DateRange{Date{2016, 1, 2}, Date{2016, 1, 1}},
+			30,
+			nil,
+		},
+	} {
+		got := test.r.Split(test.maxDays)
+		assert.Equal(t, test.want, got, "%v.Split(%d)", test.r, test.maxDays)
+
+		n := 0
+		for _, r := range got {
+			n += r.Len()
+		}
+		assert.Equal(t, test.r.Len(), n, "%v.Split(%d) total length", test.r, test.maxDays)
+	}
+
+	assert.Panics(t, func() {
+		DateRange{Date{2016, 1, 1}, Date{2016, 1, 10}}.Split(0)
+	})
+}
+
+func TestMergeRanges(t *testing.T) {
+	for _, test := range []struct {
+		desc   string
+		ranges []DateRange
+		want   []DateRange
+	}{
+		{
+			"none",
+			nil,
+			nil,
+		},
+		{
+			"disjoint, unsorted",
+			[]DateRange{
+				{Date{2016, 3, 1}, Date{2016, 3, 5}},
+				{Date{2016, 1, 1}, Date{2016, 1, 5}},
+			},
+			[]DateRange{
+				{Date{2016, 1, 1}, Date{2016, 1, 5}},
+				{Date{2016, 3, 1}, Date{2016, 3, 5}},
+			},
+		},
+		{
+			"overlapping",
+			[]DateRange{
+				{Date{2016, 1, 1}, Date{2016, 1, 10}},
+				{Date{2016, 1, 5}, Date{2016, 1, 15}},
+			},
+			[]DateRange{
+				{Date{2016, 1, 1}, Date{2016, 1, 15}},
+			},
+		},
+		{
+			"adjacent",
+			[]DateRange{
+				{Date{2016, 2, 1}, Date{2016, 2, 29}},
+				{Date{2016, 1, 1}, Date{2016, 1, 31}},
+			},
+			[]DateRange{
+				{Date{2016, 1, 1}, Date{2016, 2, 29}},
+			},
+		},
+		{
+			"one day gap",
+			[]DateRange{
+				{Date{2016, 1, 1}, Date{2016, 1, 30}},
+				{Date{2016, 2, 1}, Date{2016, 2, 29}},
+			},
+			[]DateRange{
+				{Date{2016, 1, 1}, Date{2016, 1, 30}},
+				{Date{2016, 2, 1}, Date{2016, 2, 29}},
+			},
+		},
+		{
+			"contained",
+			[]DateRange{
+				{Date{2016, 1, 1}, Date{2016, 12, 31}},
+				{Date{2016, 3, 1}, Date{2016, 3, 5}},
+				{Date{2016, 12, 31}, Date{2016, 12, 31}},
+			},
+			[]DateRange{
+				{Date{2016, 1, 1}, Date{2016, 12, 31}},
+			},
+		},
+		{
+			"identical",
+			[]DateRange{
+				{Date{2016, 1, 1}, Date{2016, 1, 5}},
+				{Date{2016, 1, 1}, Date{2016, 1, 5}},
+			},
+			[]DateRange{
+				{Date{2016, 1, 1}, Date{2016, 1, 5}},
+			},
+		},
+		{
+			"chain",
+			[]DateRange{
+				{Date{2016, 1, 9}, Date{2016, 1, 12}},
+				{Date{2016, 1, 1}, Date{2016, 1, 4}},
+				{Date{2016, 1, 5}, Date{2016, 1, 8}},
+				{Date{2016, 1, 20}, Date{2016, 1, 25}},
+			},
+			[]DateRange{
+				{Date{2016, 1, 1}, Date{2016, 1, 12}},
+				{Date{2016, 1, 20}, Date{2016, 1, 25}},
+			},
+		},
+		{
+			"empty ranges are dropped",
+			[]DateRange{
+				{Date{2016, 1, 10}, Date{2016, 1, 1}},
+				{Date{2016, 1, 20}, Date{2016, 1, 25}},
+			},
+			[]DateRange{
+				{Date{2016, 1, 20}, Date{2016, 1, 25}},
+			},
+		},
+	} {
+		assert.Equal(t, test.want, MergeRanges(test.ranges), test.desc)
+	}
+
+	ranges := []DateRange{
+		{Date{2016, 3, 1}, Date{2016, 3, 5}},
+		{Date{2016, 1, 1}, Date{2016, 3, 2}},
+	}
+	MergeRanges(ranges)
+	assert.Equal(t, Date{2016, 3, 1}, ranges[0].Start, "input was modified")
+}
+
+func TestMonthStarts(t *testing.T) {
+	for _, test := range monthStartsCases {
+		assert.Equal(t, test.want, MonthStarts(test.start, test.end), "MonthStarts(%v, %v)", test.start, test.end)
+	}
+}
@@ -0,0 +1,139 @@
+package civil
+
+import (
+	"time"
+)
+
+// Frequency is the unit a Recurrence repeats in.
+type Frequency int
+
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+// Recurrence describes a schedule of dates repeating every Interval units of
+// Frequency, beginning at Start. An Interval less than one is treated as one.
+//
+// Monthly and yearly occurrences are always computed from Start rather than
+// from the previous occurrence, so a recurrence starting on the 31st falls on
+// the last day of shorter months and returns to the 31st afterwards.
+//
+// If Ordinal is non-zero and Frequency is Monthly, occurrences instead fall on
+// the Ordinal'th Weekday of each month, as with NthWeekdayOfMonth. Months
+// without that occurrence are skipped, as is an occurrence in Start's month
+// that is before Start.
+type Recurrence struct {
+	Start     Date
+	Frequency Frequency
+	Interval  int
+	Weekday   time.Weekday
+	Ordinal   int
+}
+
+func (r Recurrence) interval() int {
+	if r.Interval < 1 {
+		return 1
+	}
+	return r.Interval
+}
+
+// at returns the kth occurrence of r, and whether it exists.
+func (r Recurrence) at(k int) (Date, bool) {
+	n := k * r.interval()
+
+	switch r.Frequency {
+	case Daily:
+		return r.Start.AddDays(n), true
+	case Weekly:
+		return r.Start.AddDays(n * 7), true
+	case Monthly:
+		if r.Ordinal != 0 {
+			m := Date{Year: r.Start.Year, Month: r.Start.Month, Day: 1}.AddMonths(n)
+			d, err := NthWeekdayOfMonth(m.Year, m.Month, r.Weekday, r.Ordinal)
+			return d, err == nil && !d.Before(r.Start)
+		}
+		return r.Start.AddMonths(n), true
+	case Yearly:
+		return r.Start.AddYears(n), true
+	}
+
+	return Date{}, false
+}
+
+// index returns an estimate of the index of the first occurrence after d. It
+// never overshoots, so callers step forwards from it.
+func (r Recurrence) index(d Date) int {
+	if d.Before(r.Start) {
+		return 0
+	}
+
+	switch r.Frequency {
+	case Daily:
+		return d.DaysSince(r.Start) / r.interval()
+	case Weekly:
+		return d.DaysSince(r.Start) / 7 / r.interval()
+	case Monthly:
+		return ((d.Year-r.Start.Year)*12 + int(d.Month) - int(r.Start.Month)) / r.interval()
+	case Yearly:
+		return (d.Year - r.Start.Year) / r.interval()
+	}
+
+	return 0
+}
+
+// valid reports whether r has any occurrences at all.
+func (r Recurrence) valid() bool {
+	if r.Frequency < Daily || r.Frequency > Yearly {
+		return false
+	}
+	return r.Frequency != Monthly || (r.Ordinal >= -5 && r.Ordinal <= 5)
+}
+
+// maxRecurrenceSearch bounds the search for an occurrence. The Gregorian
+// calendar repeats every 400 years, or 4800 months, so if none of the next
+// 4800 candidates exist, none ever will.
+const maxRecurrenceSearch = 4800
+
+// next returns the first occurrence strictly after d, or ok as false if r
+// has no occurrences after d.
+func (r Recurrence) next(d Date) (Date, bool) {
+	if !r.valid() {
+		return Date{}, false
+	}
+
+	start := r.index(d)
+	for k := start; k <= start+maxRecurrenceSearch; k++ {
+		if o, ok := r.at(k); ok && o.After(d) {
+			return o, true
+		}
+	}
+
+	return Date{}, false
+}
+
+// Next returns the first occurrence of r strictly after the given date. If
+// after is before Start, the result is Start. If r can never occur, such as
+// with an Ordinal beyond five or a fifth Monday in every fourth February, the
+// result is the zero Date.
+func (r Recurrence) Next(after Date) Date {
+	d, _ := r.next(after)
+	return d
+}
+
+// Occurrences returns the first n occurrences of r strictly after the given
+// date. If r runs out of occurrences, as with Next's zero Date, the result
+// has fewer than n dates.
+func (r Recurrence) Occurrences(after Date, n int) []Date {
+	var l []Date
+	for d := after; len(l) < n; {
+		var ok bool
+		if d, ok = r.next(d); !ok {
+			break
+		}
+		l = append(l, d)
+	}
+	return l
+}
@@ -0,0 +1,21 @@
+//go:build go1.23
+
+package civil
+
+import (
+	"iter"
+)
+
+// NextN returns an iterator over the first n occurrences of r strictly after
+// the given date. It yields the same dates as Occurrences.
+func (r Recurrence) NextN(after Date, n int) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		d := after
+		for i := 0; i < n; i++ {
+			var ok bool
+			if d, ok = r.next(d); !ok || !yield(d) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,19 @@
+//go:build go1.23
+
+package civil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurrenceNextN(t *testing.T) {
+	for _, test := range occurrencesCases {
+		var got []Date
+		for d := range test.r.NextN(test.after, test.n) {
+			got = append(got, d)
+		}
+		assert.Equal(t, test.want, got, "%#v.NextN(%v, %d)", test.r, test.after, test.n)
+	}
+}
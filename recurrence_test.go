@@ -0,0 +1,79 @@
+package civil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurrenceNext(t *testing.T) {
+	for _, test := range []struct {
+		desc  string
+		r     Recurrence
+		after Date
+		want  Date
+	}{
+		{"daily before start", Recurrence{Start: Date{2016, 1, 10}, Frequency: Daily, Interval: 1}, Date{2016, 1, 1}, Date{2016, 1, 10}},
+		{"daily on start", Recurrence{Start: Date{2016, 1, 10}, Frequency: Daily, Interval: 1}, Date{2016, 1, 10}, Date{2016, 1, 11}},
+		{"every 3 days", Recurrence{Start: Date{2016, 1, 10}, Frequency: Daily, Interval: 3}, Date{2016, 1, 14}, Date{2016, 1, 16}},
+		{"zero interval", Recurrence{Start: Date{2016, 1, 10}, Frequency: Daily, Interval: 0}, Date{2016, 1, 14}, Date{2016, 1, 15}},
+		{"every 2 weeks", Recurrence{Start: Date{2016, 1, 4}, Frequency: Weekly, Interval: 2}, Date{2016, 1, 18}, Date{2016, 2, 1}},
+		{"every 2 weeks, mid cycle", Recurrence{Start: Date{2016, 1, 4}, Frequency: Weekly, Interval: 2}, Date{2016, 1, 20}, Date{2016, 2, 1}},
+		{"monthly on the 15th", Recurrence{Start: Date{2016, 1, 15}, Frequency: Monthly, Interval: 1}, Date{2016, 3, 15}, Date{2016, 4, 15}},
+		{"monthly, earlier in month", Recurrence{Start: Date{2016, 1, 15}, Frequency: Monthly, Interval: 1}, Date{2016, 3, 2}, Date{2016, 3, 15}},
+		{"monthly on the 31st, february", Recurrence{Start: Date{2016, 1, 31}, Frequency: Monthly, Interval: 1}, Date{2016, 2, 1}, Date{2016, 2, 29}},
+		{"monthly on the 31st, after february", Recurrence{Start: Date{2016, 1, 31}, Frequency: Monthly, Interval: 1}, Date{2016, 2, 29}, Date{2016, 3, 31}},
+		{"quarterly", Recurrence{Start: Date{2016, 1, 31}, Frequency: Monthly, Interval: 3}, Date{2016, 2, 1}, Date{2016, 4, 30}},
+		{"yearly", Recurrence{Start: Date{2016, 2, 29}, Frequency: Yearly, Interval: 1}, Date{2016, 3, 1}, Date{2017, 2, 28}},
+		{"third thursday", Recurrence{Start: Date{2024, 1, 1}, Frequency: Monthly, Interval: 1, Weekday: time.Thursday, Ordinal: 3}, Date{2024, 1, 18}, Date{2024, 2, 15}},
+		{"third thursday, before start", Recurrence{Start: Date{2024, 1, 20}, Frequency: Monthly, Interval: 1, Weekday: time.Thursday, Ordinal: 3}, Date{2024, 1, 1}, Date{2024, 2, 15}},
+		{"last friday every 2 months", Recurrence{Start: Date{2024, 1, 1}, Frequency: Monthly, Interval: 2, Weekday: time.Friday, Ordinal: -1}, Date{2024, 1, 26}, Date{2024, 3, 29}},
+		{"fifth monday skips months", Recurrence{Start: Date{2024, 1, 1}, Frequency: Monthly, Interval: 1, Weekday: time.Monday, Ordinal: 5}, Date{2024, 1, 29}, Date{2024, 4, 29}},
+		{"fifth monday in every fourth non-leap february never occurs", Recurrence{Start: Date{2017, 2, 1}, Frequency: Monthly, Interval: 48, Weekday: time.Monday, Ordinal: 5}, Date{2017, 1, 1}, Date{}},
+		{"sixth monday never occurs", Recurrence{Start: Date{2024, 1, 1}, Frequency: Monthly, Interval: 1, Weekday: time.Monday, Ordinal: 6}, Date{2024, 1, 1}, Date{}},
+		{"yearly, back to leap day", Recurrence{Start: Date{2016, 2, 29}, Frequency: Yearly, Interval: 4}, Date{2016, 3, 1}, Date{2020, 2, 29}},
+	} {
+		if got := test.r.Next(test.after); got != test.want {
+			t.Errorf("[%s] %#v.Next(%v) = %v, want %v", test.desc, test.r, test.after, got, test.want)
+		}
+	}
+}
+
+var occurrencesCases = []struct {
+	r     Recurrence
+	after Date
+	n     int
+	want  []Date
+}{
+	{
+		r:     Recurrence{Start: Date{2016, 1, 31}, Frequency: Monthly, Interval: 1},
+		after: Date{2016, 1, 1},
+		n:     4,
+		want:  []Date{{2016, 1, 31}, {2016, 2, 29}, {2016, 3, 31}, {2016, 4, 30}},
+	},
+	{
+		r:     Recurrence{Start: Date{2024, 1, 1}, Frequency: Monthly, Interval: 1, Weekday: time.Thursday, Ordinal: 3},
+		after: Date{2023, 12, 31},
+		n:     3,
+		want:  []Date{{2024, 1, 18}, {2024, 2, 15}, {2024, 3, 21}},
+	},
+	{
+		r:     Recurrence{Start: Date{2017, 2, 1}, Frequency: Monthly, Interval: 48, Weekday: time.Monday, Ordinal: 5},
+		after: Date{2017, 1, 1},
+		n:     3,
+		want:  nil,
+	},
+	{
+		r:     Recurrence{Start: Date{2016, 1, 10}, Frequency: Daily, Interval: 1},
+		after: Date{2016, 1, 1},
+		n:     0,
+		want:  nil,
+	},
+}
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	for _, test := range occurrencesCases {
+		assert.Equal(t, test.want, test.r.Occurrences(test.after, test.n), "%#v.Occurrences(%v, %d)", test.r, test.after, test.n)
+	}
+}
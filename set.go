@@ -0,0 +1,97 @@
+package civil
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// DateSet is a set of dates. Like any map, a nil DateSet can be read from
+// but must be created with NewDateSet or make before adding to it.
+type DateSet map[Date]struct{}
+
+// NewDateSet returns a set containing dates.
+func NewDateSet(dates ...Date) DateSet {
+	s := make(DateSet, len(dates))
+	for _, d := range dates {
+		s.Add(d)
+	}
+	return s
+}
+
+func (s DateSet) Add(d Date) {
+	s[d] = struct{}{}
+}
+
+func (s DateSet) Remove(d Date) {
+	delete(s, d)
+}
+
+func (s DateSet) Contains(d Date) bool {
+	_, ok := s[d]
+	return ok
+}
+
+func (s DateSet) Len() int {
+	return len(s)
+}
+
+// Union returns a new set of the dates in either s or other.
+func (s DateSet) Union(other DateSet) DateSet {
+	r := make(DateSet, len(s)+len(other))
+	for d := range s {
+		r.Add(d)
+	}
+	for d := range other {
+		r.Add(d)
+	}
+	return r
+}
+
+// Intersect returns a new set of the dates in both s and other.
+func (s DateSet) Intersect(other DateSet) DateSet {
+	r := make(DateSet)
+	for d := range s {
+		if other.Contains(d) {
+			r.Add(d)
+		}
+	}
+	return r
+}
+
+// Difference returns a new set of the dates in s but not in other.
+func (s DateSet) Difference(other DateSet) DateSet {
+	r := make(DateSet)
+	for d := range s {
+		if !other.Contains(d) {
+			r.Add(d)
+		}
+	}
+	return r
+}
+
+// Sorted returns the dates in s in chronological order.
+func (s DateSet) Sorted() []Date {
+	r := make([]Date, 0, len(s))
+	for d := range s {
+		r = append(r, d)
+	}
+	sort.Sort(DateSlice(r))
+	return r
+}
+
+// MarshalJSON encodes s as an array of dates in chronological order.
+func (s DateSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Sorted())
+}
+
+// UnmarshalJSON decodes an array of dates into s, replacing its contents.
+func (s *DateSet) UnmarshalJSON(data []byte) error {
+	var dates []Date
+	if err := json.Unmarshal(data, &dates); err != nil {
+		return err
+	}
+
+	*s = NewDateSet(dates...)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package civil
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateSet(t *testing.T) {
+	s := NewDateSet(Date{2016, 1, 2}, Date{2016, 1, 1}, Date{2016, 1, 2})
+	assert.Equal(t, 2, s.Len())
+	assert.True(t, s.Contains(Date{2016, 1, 1}))
+	assert.False(t, s.Contains(Date{2016, 1, 3}))
+
+	s.Add(Date{2015, 12, 31})
+	s.Remove(Date{2016, 1, 2})
+	s.Remove(Date{2016, 1, 5})
+	assert.Equal(t, []Date{{2015, 12, 31}, {2016, 1, 1}}, s.Sorted())
+
+	var empty DateSet
+	assert.Equal(t, 0, empty.Len())
+	assert.False(t, empty.Contains(Date{2016, 1, 1}))
+	assert.Equal(t, []Date{}, empty.Sorted())
+}
+
+func TestDateSetOperations(t *testing.T) {
+	a := NewDateSet(Date{2016, 1, 1}, Date{2016, 1, 2}, Date{2016, 1, 3})
+	b := NewDateSet(Date{2016, 1, 3}, Date{2016, 1, 4})
+
+	assert.Equal(t, []Date{{2016, 1, 1}, {2016, 1, 2}, {2016, 1, 3}, {2016, 1, 4}}, a.Union(b).Sorted())
+	assert.Equal(t, []Date{{2016, 1, 3}}, a.Intersect(b).Sorted())
+	assert.Equal(t, []Date{{2016, 1, 1}, {2016, 1, 2}}, a.Difference(b).Sorted())
+	assert.Equal(t, []Date{{2016, 1, 4}}, b.Difference(a).Sorted())
+	assert.Equal(t, []Date{}, a.Intersect(nil).Sorted())
+
+	// None of the operations modify their operands.
+	assert.Equal(t, 3, a.Len())
+	assert.Equal(t, 2, b.Len())
+}
+
+func TestDateSetJSON(t *testing.T) {
+	s := NewDateSet(Date{2016, 3, 1}, Date{2015, 12, 31}, Date{2016, 1, 2})
+
+	data, err := json.Marshal(s)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `["2015-12-31","2016-01-02","2016-03-01"]`, string(data))
+	}
+
+	var got DateSet
+	if assert.NoError(t, json.Unmarshal(data, &got)) {
+		assert.Equal(t, s, got)
+	}
+
+	assert.Error(t, json.Unmarshal([]byte(`["2016-13-01"]`), &got))
+	assert.Error(t, json.Unmarshal([]byte(`{}`), &got))
+}
@@ -0,0 +1,48 @@
+package civil
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DateSlice attaches the methods of sort.Interface to []Date, sorting in
+// chronological order.
+type DateSlice []Date
+
+func (s DateSlice) Len() int           { return len(s) }
+func (s DateSlice) Less(i, j int) bool { return s[i].Before(s[j]) }
+func (s DateSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Sort sorts s in chronological order.
+func (s DateSlice) Sort() {
+	sort.Sort(s)
+}
+
+// SortReverse sorts s in reverse chronological order.
+func (s DateSlice) SortReverse() {
+	sort.Sort(sort.Reverse(s))
+}
+
+// ParseDates parses each of ss with ParseDate. If any fail, it returns the
+// error for the first one, prefixed with its row number counting from one,
+// so a failure in ss[41] is reported as "row 42".
+func ParseDates(ss []string) ([]Date, error) {
+	dates := make([]Date, len(ss))
+	for i, s := range ss {
+		d, err := ParseDate(s)
+		if err != nil {
+			return nil, fmt.Errorf("civil.ParseDates: row %d: %w", i+1, err)
+		}
+		dates[i] = d
+	}
+	return dates, nil
+}
+
+// FormatDates returns the String form of each of ds.
+func FormatDates(ds []Date) []string {
+	ss := make([]string, len(ds))
+	for i, d := range ds {
+		ss[i] = d.String()
+	}
+	return ss
+}
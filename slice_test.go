@@ -0,0 +1,60 @@
+package civil
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateSlice(t *testing.T) {
+	s := DateSlice{
+		{2016, 3, 1},
+		{2015, 12, 31},
+		{2016, 1, 15},
+		{2016, 1, 2},
+		{999, 1, 26},
+	}
+
+	sort.Sort(s)
+	assert.Equal(t, DateSlice{
+		{999, 1, 26},
+		{2015, 12, 31},
+		{2016, 1, 2},
+		{2016, 1, 15},
+		{2016, 3, 1},
+	}, s)
+
+	s.SortReverse()
+	assert.Equal(t, DateSlice{
+		{2016, 3, 1},
+		{2016, 1, 15},
+		{2016, 1, 2},
+		{2015, 12, 31},
+		{999, 1, 26},
+	}, s)
+
+	s.Sort()
+	assert.True(t, sort.IsSorted(s))
+}
+
+func TestParseDates(t *testing.T) {
+	dates, err := ParseDates([]string{"2016-01-02", "0003-02-04", "2016-12-31"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []Date{{2016, 1, 2}, {3, 2, 4}, {2016, 12, 31}}, dates)
+		assert.Equal(t, []string{"2016-01-02", "0003-02-04", "2016-12-31"}, FormatDates(dates))
+	}
+
+	dates, err = ParseDates([]string{"2016-01-02", "2016-02-30", "x"})
+	assert.Nil(t, dates)
+	if assert.Error(t, err) {
+		assert.Equal(t, `civil.ParseDates: row 2: civil.ParseDate: day out of range: "2016-02-30"`, err.Error())
+		assert.True(t, errors.Is(err, ErrDayOutOfRange))
+	}
+
+	dates, err = ParseDates(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, dates)
+	assert.Empty(t, FormatDates(nil))
+}
@@ -0,0 +1,68 @@
+package civil
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Strftime formats d using C strftime style directives. The supported
+// directives are:
+//
+//	%Y  year, zero padded to four digits ("1987")
+//	%y  year without century ("87")
+//	%m  month, zero padded ("04")
+//	%d  day of the month, zero padded ("05")
+//	%e  day of the month, space padded (" 5")
+//	%j  day of the year, zero padded ("105")
+//	%A  weekday name ("Wednesday")
+//	%a  short weekday name ("Wed")
+//	%B  month name ("April")
+//	%b  short month name ("Apr")
+//	%F  ISO 8601 date, equivalent to "%Y-%m-%d"
+//	%%  a literal "%"
+//
+// Any other directive, including a trailing "%", is copied to the output
+// unchanged.
+func (d Date) Strftime(format string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i == len(format)-1 {
+			b.WriteByte(format[i])
+			continue
+		}
+
+		i++
+		switch format[i] {
+		case 'Y':
+			fmt.Fprintf(&b, "%04d", d.Year)
+		case 'y':
+			fmt.Fprintf(&b, "%02d", (d.Year%100+100)%100)
+		case 'm':
+			fmt.Fprintf(&b, "%02d", d.Month)
+		case 'd':
+			fmt.Fprintf(&b, "%02d", d.Day)
+		case 'e':
+			fmt.Fprintf(&b, "%2d", d.Day)
+		case 'j':
+			fmt.Fprintf(&b, "%03d", d.YearDay())
+		case 'A':
+			b.WriteString(d.WeekdayName())
+		case 'a':
+			b.WriteString(d.WeekdayShortName())
+		case 'B':
+			b.WriteString(d.MonthName())
+		case 'b':
+			b.WriteString(d.MonthShortName())
+		case 'F':
+			b.WriteString(d.String())
+		case '%':
+			b.WriteByte('%')
+		default:
+			b.WriteByte('%')
+			b.WriteByte(format[i])
+		}
+	}
+
+	return b.String()
+}
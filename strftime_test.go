@@ -0,0 +1,32 @@
+package civil
+
+import (
+	"testing"
+)
+
+func TestStrftime(t *testing.T) {
+	for _, test := range []struct {
+		date   Date
+		format string
+		want   string
+	}{
+		{Date{1987, 4, 15}, "%Y-%m-%d", "1987-04-15"},
+		{Date{1987, 4, 15}, "%F", "1987-04-15"},
+		{Date{3, 2, 4}, "%Y-%m-%d", "0003-02-04"},
+		{Date{1987, 4, 15}, "%d/%m/%y", "15/04/87"},
+		{Date{2005, 4, 5}, "%y %e", "05  5"},
+		{Date{1987, 4, 15}, "%A, %B %d", "Wednesday, April 15"},
+		{Date{1987, 4, 15}, "%a %b", "Wed Apr"},
+		{Date{1987, 1, 1}, "%j", "001"},
+		{Date{1987, 4, 15}, "%j", "105"},
+		{Date{2016, 12, 31}, "%j", "366"},
+		{Date{1987, 4, 15}, "100%%", "100%"},
+		{Date{1987, 4, 15}, "%Q %H:%M", "%Q %H:%M"},
+		{Date{1987, 4, 15}, "trailing %", "trailing %"},
+		{Date{1987, 4, 15}, "", ""},
+	} {
+		if got := test.date.Strftime(test.format); got != test.want {
+			t.Errorf("%#v.Strftime(%q) = %q, want %q", test.date, test.format, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package civil
+
+import (
+	"text/template"
+	"time"
+)
+
+// FuncMap returns template functions for working with dates. The date is
+// always the last argument, so each function can be used in a pipeline, such
+// as {{ .Due | date_add_days 7 | date_format "Jan 2" }}.
+//
+//	date_format   func(layout string, d Date) string, as Date.Format
+//	date_add_days func(n int, d Date) Date, as Date.AddDays
+//	is_weekend    func(d Date) bool, as Date.IsWeekend
+//	today         func() Date, the current date in the local time zone
+//
+// The result can be converted for use with html/template, as in
+// htmltemplate.FuncMap(civil.FuncMap()).
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"date_format": func(layout string, d Date) string {
+			return d.Format(layout)
+		},
+		"date_add_days": func(n int, d Date) Date {
+			return d.AddDays(n)
+		},
+		"is_weekend": func(d Date) bool {
+			return d.IsWeekend()
+		},
+		"today": func() Date {
+			return Today(time.Local)
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package civil
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuncMap(t *testing.T) {
+	for _, test := range []struct {
+		tmpl string
+		want string
+	}{
+		{`{{ date_format "Jan 2, 2006" . }}`, "Mar 4, 2016"},
+		{`{{ . | date_add_days 7 }}`, "2016-03-11"},
+		{`{{ . | date_add_days -4 | date_format "2006/01/02" }}`, "2016/02/29"},
+		{`{{ is_weekend . }}`, "false"},
+		{`{{ date_add_days 1 . | is_weekend }}`, "true"},
+	} {
+		var b bytes.Buffer
+		tmpl := template.Must(template.New("").Funcs(FuncMap()).Parse(test.tmpl))
+		if assert.NoError(t, tmpl.Execute(&b, Date{2016, 3, 4}), test.tmpl) {
+			assert.Equal(t, test.want, b.String(), test.tmpl)
+		}
+	}
+}
+
+func TestFuncMapToday(t *testing.T) {
+	var b bytes.Buffer
+	tmpl := htmltemplate.Must(htmltemplate.New("").Funcs(htmltemplate.FuncMap(FuncMap())).Parse(`{{ today }}`))
+
+	start := DateOf(time.Now())
+	if assert.NoError(t, tmpl.Execute(&b, nil)) {
+		got, err := ParseDate(b.String())
+		if assert.NoError(t, err) {
+			assert.True(t, got.AfterOrOn(start) && got.BeforeOrOn(DateOf(time.Now())), "today = %v", got)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package civil
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Time is a time of day, without a date or location.
+type Time struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+func TimeOf(t time.Time) Time {
+	var tm Time
+	tm.Hour, tm.Minute, tm.Second = t.Clock()
+	tm.Nanosecond = t.Nanosecond()
+	return tm
+}
+
+// ParseTime parses a time of day in the form "15:04:05", optionally followed
+// by a fraction of a second of up to nine digits, e.g. "15:04:05.123".
+func ParseTime(s string) (Time, error) {
+	t, err := time.Parse("15:04:05.999999999", s)
+	if err != nil {
+		return Time{}, err
+	}
+
+	return TimeOf(t), nil
+}
+
+// String returns t in the form "15:04:05", with nine digits of fractional
+// seconds appended if t.Nanosecond isn't zero.
+func (t Time) String() string {
+	if t.Nanosecond == 0 {
+		return fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+	}
+	return fmt.Sprintf("%02d:%02d:%02d.%09d", t.Hour, t.Minute, t.Second, t.Nanosecond)
+}
+
+func (t Time) IsValid() bool {
+	return t.Hour >= 0 && t.Hour < 24 &&
+		t.Minute >= 0 && t.Minute < 60 &&
+		t.Second >= 0 && t.Second < 60 &&
+		t.Nanosecond >= 0 && t.Nanosecond < 1e9
+}
+
+func (t Time) On(other Time) bool {
+	return t == other
+}
+
+func (t Time) Before(other Time) bool {
+	if t.Hour != other.Hour {
+		return t.Hour < other.Hour
+	}
+	if t.Minute != other.Minute {
+		return t.Minute < other.Minute
+	}
+	if t.Second != other.Second {
+		return t.Second < other.Second
+	}
+	return t.Nanosecond < other.Nanosecond
+}
+
+func (t Time) BeforeOrOn(other Time) bool {
+	return t.On(other) || t.Before(other)
+}
+
+func (t Time) After(other Time) bool {
+	return other.Before(t)
+}
+
+func (t Time) AfterOrOn(other Time) bool {
+	return t.On(other) || t.After(other)
+}
+
+// Compare returns -1 if t is before other, 0 if they're the same time and +1
+// if t is after other.
+func (t Time) Compare(other Time) int {
+	switch {
+	case t.Before(other):
+		return -1
+	case t.After(other):
+		return +1
+	}
+	return 0
+}
+
+func (t Time) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *Time) UnmarshalText(text []byte) error {
+	var err error
+	*t, err = ParseTime(string(text))
+	return err
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	v, err := ParseTime(s)
+	if err != nil {
+		return err
+	}
+
+	*t = v
+
+	return nil
+}
+
+// Scan implements the sql.Scanner interface. A NULL value sets t to the zero
+// Time.
+func (t *Time) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*t = Time{}
+		return nil
+	case time.Time:
+		*t = TimeOf(v)
+		return nil
+	case string:
+		tm, err := ParseTime(v)
+		if err != nil {
+			return err
+		}
+		*t = tm
+		return nil
+	case []byte:
+		tm, err := ParseTime(string(v))
+		if err != nil {
+			return err
+		}
+		*t = tm
+		return nil
+	default:
+		return fmt.Errorf("civil.Time.Scan: can't scan into %T", src)
+	}
+}
+
+func (t Time) Value() (driver.Value, error) {
+	return t.String(), nil
+}
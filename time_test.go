@@ -0,0 +1,178 @@
+package civil
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimes(t *testing.T) {
+	for _, test := range []struct {
+		time    Time
+		wantStr string
+	}{
+		{Time{15, 4, 5, 0}, "15:04:05"},
+		{Time{0, 0, 0, 0}, "00:00:00"},
+		{Time{23, 59, 59, 999999999}, "23:59:59.999999999"},
+		{Time{1, 2, 3, 500000000}, "01:02:03.500000000"},
+		{TimeOf(time.Date(2014, 8, 20, 15, 8, 43, 1, time.Local)), "15:08:43.000000001"},
+	} {
+		if got := test.time.String(); got != test.wantStr {
+			t.Errorf("%#v.String() = %q, want %q", test.time, got, test.wantStr)
+		}
+	}
+}
+
+func TestTimeIsValid(t *testing.T) {
+	for _, test := range []struct {
+		time Time
+		want bool
+	}{
+		{Time{0, 0, 0, 0}, true},
+		{Time{23, 59, 59, 999999999}, true},
+		{Time{24, 0, 0, 0}, false},
+		{Time{0, 60, 0, 0}, false},
+		{Time{0, 0, 60, 0}, false},
+		{Time{0, 0, 0, 1000000000}, false},
+		{Time{-1, 0, 0, 0}, false},
+		{Time{0, -1, 0, 0}, false},
+		{Time{0, 0, -1, 0}, false},
+		{Time{0, 0, 0, -1}, false},
+	} {
+		if got := test.time.IsValid(); got != test.want {
+			t.Errorf("%#v: got %t, want %t", test.time, got, test.want)
+		}
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	for _, test := range []struct {
+		str  string
+		want Time
+		err  bool
+	}{
+		{str: "15:04:05", want: Time{15, 4, 5, 0}},
+		{str: "00:00:00", want: Time{0, 0, 0, 0}},
+		{str: "23:59:59.999999999", want: Time{23, 59, 59, 999999999}},
+		{str: "01:02:03.5", want: Time{1, 2, 3, 500000000}},
+		{str: "01:02:03.000001", want: Time{1, 2, 3, 1000}},
+		{str: "24:00:00", err: true},
+		{str: "1:02:03", want: Time{1, 2, 3, 0}},
+		{str: "01:02", err: true},
+		{str: "01:02:03x", err: true},
+		{str: "01:02:03.", err: true},
+		{str: "", err: true},
+	} {
+		got, err := ParseTime(test.str)
+		if got != test.want {
+			t.Errorf("ParseTime(%q) = %+v, want %+v", test.str, got, test.want)
+		}
+		if (err != nil) != test.err {
+			t.Errorf("ParseTime(%q): got error %v, want error %t", test.str, err, test.err)
+		}
+	}
+}
+
+func TestTimeComparison(t *testing.T) {
+	for _, test := range []struct {
+		t1, t2            Time
+		before, after, on bool
+	}{
+		{Time{1, 2, 3, 4}, Time{1, 2, 3, 4}, false, false, true},
+		{Time{1, 2, 3, 4}, Time{1, 2, 3, 5}, true, false, false},
+		{Time{1, 2, 3, 4}, Time{1, 2, 4, 0}, true, false, false},
+		{Time{1, 2, 3, 4}, Time{1, 3, 0, 0}, true, false, false},
+		{Time{1, 2, 3, 4}, Time{2, 0, 0, 0}, true, false, false},
+		{Time{2, 0, 0, 0}, Time{1, 59, 59, 999999999}, false, true, false},
+		{Time{1, 2, 3, 5}, Time{1, 2, 3, 4}, false, true, false},
+	} {
+		t.Run(fmt.Sprintf("%v vs %v", test.t1, test.t2), func(t *testing.T) {
+			if got := test.t1.On(test.t2); got != test.on {
+				t.Errorf("%v.On(%v): got %t, want %t", test.t1, test.t2, got, test.on)
+			}
+			if got := test.t1.Before(test.t2); got != test.before {
+				t.Errorf("%v.Before(%v): got %t, want %t", test.t1, test.t2, got, test.before)
+			}
+			if got := test.t1.After(test.t2); got != test.after {
+				t.Errorf("%v.After(%v): got %t, want %t", test.t1, test.t2, got, test.after)
+			}
+			if got := test.t1.BeforeOrOn(test.t2); got != (test.before || test.on) {
+				t.Errorf("%v.BeforeOrOn(%v): got %t, want %t", test.t1, test.t2, got, test.before || test.on)
+			}
+			if got := test.t1.AfterOrOn(test.t2); got != (test.after || test.on) {
+				t.Errorf("%v.AfterOrOn(%v): got %t, want %t", test.t1, test.t2, got, test.after || test.on)
+			}
+			want := 0
+			if test.before {
+				want = -1
+			} else if test.after {
+				want = +1
+			}
+			if got := test.t1.Compare(test.t2); got != want {
+				t.Errorf("%v.Compare(%v): got %d, want %d", test.t1, test.t2, got, want)
+			}
+		})
+	}
+}
+
+func TestTimeJSON(t *testing.T) {
+	for _, test := range []struct {
+		value Time
+		want  string
+	}{
+		{Time{15, 4, 5, 0}, `"15:04:05"`},
+		{Time{15, 4, 5, 6}, `"15:04:05.000000006"`},
+	} {
+		b, err := json.Marshal(test.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := string(b); got != test.want {
+			t.Errorf("%#v: got %s, want %s", test.value, got, test.want)
+		}
+
+		var got Time
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatalf("%s: %v", b, err)
+		}
+		assert.Equal(t, test.value, got)
+	}
+
+	var tm Time
+	for _, bad := range []string{"", `""`, `"bad"`, `"15:04:05x"`, `150405`} {
+		if json.Unmarshal([]byte(bad), &tm) == nil {
+			t.Errorf("%q, Time: got nil, want error", bad)
+		}
+	}
+}
+
+func TestTimeScanValue(t *testing.T) {
+	for _, test := range []struct {
+		src  interface{}
+		want Time
+		err  bool
+	}{
+		{src: nil, want: Time{}},
+		{src: time.Date(1987, 4, 15, 15, 4, 5, 6, time.UTC), want: Time{15, 4, 5, 6}},
+		{src: "15:04:05", want: Time{15, 4, 5, 0}},
+		{src: []byte("15:04:05.5"), want: Time{15, 4, 5, 500000000}},
+		{src: "bad", err: true},
+		{src: int64(1), err: true},
+	} {
+		var got Time
+		err := got.Scan(test.src)
+		if got != test.want {
+			t.Errorf("Scan(%#v) = %+v, want %+v", test.src, got, test.want)
+		}
+		if (err != nil) != test.err {
+			t.Errorf("Scan(%#v): got error %v, want error %t", test.src, err, test.err)
+		}
+	}
+
+	v, err := Time{15, 4, 5, 0}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "15:04:05", v)
+}
@@ -0,0 +1,119 @@
+package civil
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// YearMonth is a month of a particular year, without a day.
+type YearMonth struct {
+	Year  int
+	Month time.Month
+}
+
+func YearMonthOf(d Date) YearMonth {
+	return YearMonth{Year: d.Year, Month: d.Month}
+}
+
+func (d Date) YearMonth() YearMonth {
+	return YearMonthOf(d)
+}
+
+func ParseYearMonth(s string) (YearMonth, error) {
+	t, err := time.Parse("2006-01", s)
+	if err != nil {
+		return YearMonth{}, err
+	}
+
+	return YearMonth{Year: t.Year(), Month: t.Month()}, nil
+}
+
+func (m YearMonth) String() string {
+	return fmt.Sprintf("%04d-%02d", m.Year, m.Month)
+}
+
+func (m YearMonth) IsValid() bool {
+	return m.Month >= time.January && m.Month <= time.December
+}
+
+func (m YearMonth) FirstDay() Date {
+	return Date{Year: m.Year, Month: m.Month, Day: 1}
+}
+
+func (m YearMonth) LastDay() Date {
+	return Date{Year: m.Year, Month: m.Month, Day: maxDay(m.Year, m.Month)}
+}
+
+func (m YearMonth) AddMonths(n int) YearMonth {
+	return YearMonthOf(m.FirstDay().AddMonths(n))
+}
+
+func (m YearMonth) MonthsUntil(other YearMonth) int {
+	return m.FirstDay().MonthsUntil(other.FirstDay())
+}
+
+func (m YearMonth) On(other YearMonth) bool {
+	return m == other
+}
+
+func (m YearMonth) Before(other YearMonth) bool {
+	if m.Year != other.Year {
+		return m.Year < other.Year
+	}
+	return m.Month < other.Month
+}
+
+func (m YearMonth) BeforeOrOn(other YearMonth) bool {
+	return m.On(other) || m.Before(other)
+}
+
+func (m YearMonth) After(other YearMonth) bool {
+	return other.Before(m)
+}
+
+func (m YearMonth) AfterOrOn(other YearMonth) bool {
+	return m.On(other) || m.After(other)
+}
+
+// Compare returns -1 if m is before other, 0 if they're the same month and
+// +1 if m is after other.
+func (m YearMonth) Compare(other YearMonth) int {
+	switch {
+	case m.Before(other):
+		return -1
+	case m.After(other):
+		return +1
+	}
+	return 0
+}
+
+func (m YearMonth) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *YearMonth) UnmarshalText(text []byte) error {
+	var err error
+	*m, err = ParseYearMonth(string(text))
+	return err
+}
+
+func (m YearMonth) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+func (m *YearMonth) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	v, err := ParseYearMonth(s)
+	if err != nil {
+		return err
+	}
+
+	*m = v
+
+	return nil
+}
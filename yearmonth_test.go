@@ -0,0 +1,148 @@
+package civil
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYearMonth(t *testing.T) {
+	for _, test := range []struct {
+		date        Date
+		want        YearMonth
+		str         string
+		first, last Date
+	}{
+		{Date{2016, 1, 15}, YearMonth{2016, 1}, "2016-01", Date{2016, 1, 1}, Date{2016, 1, 31}},
+		{Date{2016, 2, 29}, YearMonth{2016, 2}, "2016-02", Date{2016, 2, 1}, Date{2016, 2, 29}},
+		{Date{2015, 2, 1}, YearMonth{2015, 2}, "2015-02", Date{2015, 2, 1}, Date{2015, 2, 28}},
+		{Date{999, 12, 25}, YearMonth{999, 12}, "0999-12", Date{999, 12, 1}, Date{999, 12, 31}},
+	} {
+		got := test.date.YearMonth()
+		if got != test.want {
+			t.Errorf("%v.YearMonth() = %#v, want %#v", test.date, got, test.want)
+		}
+		if s := got.String(); s != test.str {
+			t.Errorf("%#v.String() = %q, want %q", got, s, test.str)
+		}
+		if d := got.FirstDay(); d != test.first {
+			t.Errorf("%v.FirstDay() = %v, want %v", got, d, test.first)
+		}
+		if d := got.LastDay(); d != test.last {
+			t.Errorf("%v.LastDay() = %v, want %v", got, d, test.last)
+		}
+	}
+}
+
+func TestParseYearMonth(t *testing.T) {
+	for _, test := range []struct {
+		str  string
+		want YearMonth // if empty, expect an error
+	}{
+		{"2016-01", YearMonth{2016, 1}},
+		{"2016-12", YearMonth{2016, 12}},
+		{"0003-02", YearMonth{3, 2}},
+		{"2016-13", YearMonth{}},
+		{"2016-1", YearMonth{}},
+		{"2016-01-02", YearMonth{}},
+		{"", YearMonth{}},
+	} {
+		got, err := ParseYearMonth(test.str)
+		if got != test.want {
+			t.Errorf("ParseYearMonth(%q) = %+v, want %+v", test.str, got, test.want)
+		}
+		if (err != nil) != (test.want == YearMonth{}) {
+			t.Errorf("ParseYearMonth(%q): unexpected error state %v", test.str, err)
+		}
+	}
+}
+
+func TestYearMonthAddMonths(t *testing.T) {
+	for _, test := range []struct {
+		start, end YearMonth
+		n          int
+	}{
+		{YearMonth{2016, 1}, YearMonth{2016, 1}, 0},
+		{YearMonth{2016, 1}, YearMonth{2016, 2}, 1},
+		{YearMonth{2016, 12}, YearMonth{2017, 1}, 1},
+		{YearMonth{2016, 1}, YearMonth{2015, 12}, -1},
+		{YearMonth{2016, 1}, YearMonth{2018, 3}, 26},
+	} {
+		if got := test.start.AddMonths(test.n); got != test.end {
+			t.Errorf("%v.AddMonths(%d) = %v, want %v", test.start, test.n, got, test.end)
+		}
+		if got := test.start.MonthsUntil(test.end); got != test.n {
+			t.Errorf("%v.MonthsUntil(%v) = %d, want %d", test.start, test.end, got, test.n)
+		}
+	}
+}
+
+func TestYearMonthComparison(t *testing.T) {
+	for _, test := range []struct {
+		m1, m2            YearMonth
+		before, after, on bool
+	}{
+		{YearMonth{2016, 1}, YearMonth{2016, 1}, false, false, true},
+		{YearMonth{2016, 1}, YearMonth{2016, 2}, true, false, false},
+		{YearMonth{2016, 12}, YearMonth{2017, 1}, true, false, false},
+		{YearMonth{2017, 1}, YearMonth{2016, 12}, false, true, false},
+		{YearMonth{2016, 2}, YearMonth{2016, 1}, false, true, false},
+	} {
+		t.Run(fmt.Sprintf("%v vs %v", test.m1, test.m2), func(t *testing.T) {
+			if got := test.m1.On(test.m2); got != test.on {
+				t.Errorf("%v.On(%v): got %t, want %t", test.m1, test.m2, got, test.on)
+			}
+			if got := test.m1.Before(test.m2); got != test.before {
+				t.Errorf("%v.Before(%v): got %t, want %t", test.m1, test.m2, got, test.before)
+			}
+			if got := test.m1.After(test.m2); got != test.after {
+				t.Errorf("%v.After(%v): got %t, want %t", test.m1, test.m2, got, test.after)
+			}
+			if got := test.m1.BeforeOrOn(test.m2); got != (test.before || test.on) {
+				t.Errorf("%v.BeforeOrOn(%v): got %t, want %t", test.m1, test.m2, got, test.before || test.on)
+			}
+			if got := test.m1.AfterOrOn(test.m2); got != (test.after || test.on) {
+				t.Errorf("%v.AfterOrOn(%v): got %t, want %t", test.m1, test.m2, got, test.after || test.on)
+			}
+			want := 0
+			if test.before {
+				want = -1
+			} else if test.after {
+				want = +1
+			}
+			if got := test.m1.Compare(test.m2); got != want {
+				t.Errorf("%v.Compare(%v): got %d, want %d", test.m1, test.m2, got, want)
+			}
+		})
+	}
+}
+
+func TestYearMonthJSON(t *testing.T) {
+	b, err := json.Marshal(map[string]YearMonth{"m": {1987, 4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `{"m":"1987-04"}`, string(b))
+
+	var got map[YearMonth]int
+	if err := json.Unmarshal([]byte(`{"1987-04":1,"2016-12":2}`), &got); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[YearMonth]int{{1987, 4}: 1, {2016, 12}: 2}, got)
+
+	var m YearMonth
+	for _, bad := range []string{"", `""`, `"bad"`, `"1987-04-15"`, `198704`} {
+		if json.Unmarshal([]byte(bad), &m) == nil {
+			t.Errorf("%q, YearMonth: got nil, want error", bad)
+		}
+	}
+}
+
+func TestYearMonthIsValid(t *testing.T) {
+	assert.True(t, YearMonth{2016, 1}.IsValid())
+	assert.True(t, YearMonth{-1, 12}.IsValid())
+	assert.False(t, YearMonth{2016, 0}.IsValid())
+	assert.False(t, YearMonth{2016, 13}.IsValid())
+}